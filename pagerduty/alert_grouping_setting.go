@@ -0,0 +1,292 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AlertGroupingSettingService handles the communication with alert grouping
+// setting related methods of the PagerDuty API.
+type AlertGroupingSettingService service
+
+// AlertGroupingSettingType is the kind of grouping an alert grouping setting
+// applies.
+type AlertGroupingSettingType string
+
+const (
+	AlertGroupingSettingTypeTime                    AlertGroupingSettingType = "time"
+	AlertGroupingSettingTypeIntelligent             AlertGroupingSettingType = "intelligent"
+	AlertGroupingSettingTypeContentBased            AlertGroupingSettingType = "content_based"
+	AlertGroupingSettingTypeContentBasedIntelligent AlertGroupingSettingType = "content_based_intelligent"
+)
+
+// AlertGroupingSettingConfig is implemented by the configurations of the
+// different alert grouping setting types.
+type AlertGroupingSettingConfig interface {
+	isAlertGroupingSettingConfig()
+}
+
+// AlertGroupingSettingTimeConfig configures time based grouping. Alerts are
+// grouped while the incident is open and no more than Timeout seconds apart.
+type AlertGroupingSettingTimeConfig struct {
+	Timeout int `json:"timeout"`
+}
+
+// AlertGroupingSettingIntelligentConfig configures intelligent grouping.
+type AlertGroupingSettingIntelligentConfig struct {
+	TimeWindow int      `json:"time_window,omitempty"`
+	IAGFields  []string `json:"iag_fields,omitempty"`
+}
+
+// AlertGroupingSettingContentBasedConfig configures content based grouping,
+// with or without intelligent grouping on top of it.
+type AlertGroupingSettingContentBasedConfig struct {
+	Aggregate             string   `json:"aggregate,omitempty"`
+	Fields                []string `json:"fields,omitempty"`
+	TimeWindow            int      `json:"time_window,omitempty"`
+	RecommendedTimeWindow *int     `json:"recommended_time_window,omitempty"`
+}
+
+// AlertGroupingSettingRawConfig holds the configuration of an alert grouping
+// setting type unknown to this library.
+type AlertGroupingSettingRawConfig json.RawMessage
+
+// MarshalJSON returns the raw configuration.
+func (c AlertGroupingSettingRawConfig) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte("null"), nil
+	}
+	return c, nil
+}
+
+func (*AlertGroupingSettingTimeConfig) isAlertGroupingSettingConfig()         {}
+func (*AlertGroupingSettingIntelligentConfig) isAlertGroupingSettingConfig()  {}
+func (*AlertGroupingSettingContentBasedConfig) isAlertGroupingSettingConfig() {}
+func (AlertGroupingSettingRawConfig) isAlertGroupingSettingConfig()           {}
+
+// AlertGroupingSetting represents an alert grouping setting shared by one or
+// more services.
+type AlertGroupingSetting struct {
+	ID          string                     `json:"id,omitempty"`
+	Name        string                     `json:"name,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Type        AlertGroupingSettingType   `json:"type,omitempty"`
+	Config      AlertGroupingSettingConfig `json:"config,omitempty"`
+	Services    []*ServiceReference        `json:"services,omitempty"`
+	CreatedAt   string                     `json:"created_at,omitempty"`
+	UpdatedAt   string                     `json:"updated_at,omitempty"`
+}
+
+type rawAlertGroupingSetting struct {
+	ID          string                   `json:"id,omitempty"`
+	Name        string                   `json:"name,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	Type        AlertGroupingSettingType `json:"type,omitempty"`
+	Config      json.RawMessage          `json:"config,omitempty"`
+	Services    []*ServiceReference      `json:"services,omitempty"`
+	CreatedAt   string                   `json:"created_at,omitempty"`
+	UpdatedAt   string                   `json:"updated_at,omitempty"`
+}
+
+func (a *AlertGroupingSetting) UnmarshalJSON(data []byte) error {
+	var p rawAlertGroupingSetting
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return err
+	}
+	*a = AlertGroupingSetting{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Type:        p.Type,
+		Services:    p.Services,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+	if len(p.Config) == 0 || string(p.Config) == "null" {
+		return nil
+	}
+
+	var config AlertGroupingSettingConfig
+	switch p.Type {
+	case AlertGroupingSettingTypeTime:
+		config = new(AlertGroupingSettingTimeConfig)
+	case AlertGroupingSettingTypeIntelligent:
+		config = new(AlertGroupingSettingIntelligentConfig)
+	case AlertGroupingSettingTypeContentBased, AlertGroupingSettingTypeContentBasedIntelligent:
+		config = new(AlertGroupingSettingContentBasedConfig)
+	default:
+		a.Config = AlertGroupingSettingRawConfig(p.Config)
+		return nil
+	}
+	if err := json.Unmarshal(p.Config, config); err != nil {
+		return fmt.Errorf("failed to decode %s alert grouping config: %v", p.Type, err)
+	}
+	a.Config = config
+
+	return nil
+}
+
+// AlertGroupingSettingPayload represents payload with an alert grouping
+// setting object.
+type AlertGroupingSettingPayload struct {
+	AlertGroupingSetting *AlertGroupingSetting `json:"alert_grouping_setting,omitempty"`
+}
+
+// ListAlertGroupingSettingsOptions represents options when listing alert
+// grouping settings.
+type ListAlertGroupingSettingsOptions struct {
+	Limit      int      `url:"limit,omitempty"`
+	After      string   `url:"after,omitempty"`
+	Before     string   `url:"before,omitempty"`
+	Total      bool     `url:"total,omitempty"`
+	ServiceIDs []string `url:"service_ids,omitempty,brackets"`
+}
+
+// ListAlertGroupingSettingsResponse represents a list response of alert
+// grouping settings.
+type ListAlertGroupingSettingsResponse struct {
+	AlertGroupingSettings []*AlertGroupingSetting `json:"alert_grouping_settings,omitempty"`
+	After                 string                  `json:"after,omitempty"`
+	Before                string                  `json:"before,omitempty"`
+	Limit                 int                     `json:"limit,omitempty"`
+	Total                 int                     `json:"total,omitempty"`
+}
+
+type listAlertGroupingSettingsOptionsGen struct {
+	options *ListAlertGroupingSettingsOptions
+}
+
+func (o *listAlertGroupingSettingsOptionsGen) currentCursor() string {
+	return o.options.After
+}
+
+func (o *listAlertGroupingSettingsOptionsGen) changeCursor(s string) {
+	o.options.After = s
+}
+
+func (o *listAlertGroupingSettingsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// List lists existing alert grouping settings. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of alert grouping settings will be returned.
+func (s *AlertGroupingSettingService) List(o *ListAlertGroupingSettingsOptions) (*ListAlertGroupingSettingsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing alert grouping settings. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of alert grouping settings will be returned.
+func (s *AlertGroupingSettingService) ListContext(ctx context.Context, o *ListAlertGroupingSettingsOptions) (*ListAlertGroupingSettingsResponse, *Response, error) {
+	u := "/alert_grouping_settings"
+	v := new(ListAlertGroupingSettingsResponse)
+
+	if o == nil {
+		o = &ListAlertGroupingSettingsOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	settings := make([]*AlertGroupingSetting, 0)
+
+	// Create a handler closure capable of parsing data from the alert grouping
+	// settings endpoint and appending resultant settings to the return slice.
+	responseHandler := func(response *Response) (CursorListResp, *Response, error) {
+		var result ListAlertGroupingSettingsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return CursorListResp{}, response, err
+		}
+
+		settings = append(settings, result.AlertGroupingSettings...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return CursorListResp{
+			Limit:      result.Limit,
+			NextCursor: result.After,
+		}, response, nil
+	}
+	err := s.client.newRequestCursorPagedGetQueryDoContext(ctx, u, responseHandler, &listAlertGroupingSettingsOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.AlertGroupingSettings = settings
+
+	return v, nil, nil
+}
+
+// Get gets an alert grouping setting.
+func (s *AlertGroupingSettingService) Get(id string) (*AlertGroupingSetting, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext gets an alert grouping setting.
+func (s *AlertGroupingSettingService) GetContext(ctx context.Context, id string) (*AlertGroupingSetting, *Response, error) {
+	u := fmt.Sprintf("/alert_grouping_settings/%s", id)
+	v := new(AlertGroupingSettingPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.AlertGroupingSetting, resp, nil
+}
+
+// Create creates a new alert grouping setting.
+func (s *AlertGroupingSettingService) Create(setting *AlertGroupingSetting) (*AlertGroupingSetting, *Response, error) {
+	return s.CreateContext(context.Background(), setting)
+}
+
+// CreateContext creates a new alert grouping setting.
+func (s *AlertGroupingSettingService) CreateContext(ctx context.Context, setting *AlertGroupingSetting) (*AlertGroupingSetting, *Response, error) {
+	u := "/alert_grouping_settings"
+	v := new(AlertGroupingSettingPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &AlertGroupingSettingPayload{AlertGroupingSetting: setting}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.AlertGroupingSetting, resp, nil
+}
+
+// Update updates an existing alert grouping setting.
+func (s *AlertGroupingSettingService) Update(id string, setting *AlertGroupingSetting) (*AlertGroupingSetting, *Response, error) {
+	return s.UpdateContext(context.Background(), id, setting)
+}
+
+// UpdateContext updates an existing alert grouping setting.
+func (s *AlertGroupingSettingService) UpdateContext(ctx context.Context, id string, setting *AlertGroupingSetting) (*AlertGroupingSetting, *Response, error) {
+	u := fmt.Sprintf("/alert_grouping_settings/%s", id)
+	v := new(AlertGroupingSettingPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &AlertGroupingSettingPayload{AlertGroupingSetting: setting}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.AlertGroupingSetting, resp, nil
+}
+
+// Delete removes an existing alert grouping setting.
+func (s *AlertGroupingSettingService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing alert grouping setting.
+func (s *AlertGroupingSettingService) DeleteContext(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("/alert_grouping_settings/%s", id)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
@@ -0,0 +1,145 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAlertGroupingSettingsList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/alert_grouping_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "service_ids[]", "PSVC1")
+		switch r.URL.Query().Get("after") {
+		case "":
+			w.Write([]byte(`{"alert_grouping_settings": [{"id": "PAGS1", "name": "Time", "type": "time", "config": {"timeout": 300}, "services": [{"id": "PSVC1"}]}], "limit": 1, "after": "abc"}`))
+		case "abc":
+			w.Write([]byte(`{"alert_grouping_settings": [{"id": "PAGS2", "name": "Content", "type": "content_based", "config": {"aggregate": "all", "fields": ["summary"], "time_window": 600}}], "limit": 1}`))
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("after"))
+		}
+	})
+
+	resp, _, err := client.AlertGroupingSettings.List(&ListAlertGroupingSettingsOptions{ServiceIDs: []string{"PSVC1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAlertGroupingSettingsResponse{
+		AlertGroupingSettings: []*AlertGroupingSetting{
+			{
+				ID:       "PAGS1",
+				Name:     "Time",
+				Type:     AlertGroupingSettingTypeTime,
+				Config:   &AlertGroupingSettingTimeConfig{Timeout: 300},
+				Services: []*ServiceReference{{ID: "PSVC1"}},
+			},
+			{
+				ID:     "PAGS2",
+				Name:   "Content",
+				Type:   AlertGroupingSettingTypeContentBased,
+				Config: &AlertGroupingSettingContentBasedConfig{Aggregate: "all", Fields: []string{"summary"}, TimeWindow: 600},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestAlertGroupingSettingsCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &AlertGroupingSetting{
+		Name:     "Intelligent",
+		Type:     AlertGroupingSettingTypeIntelligent,
+		Config:   &AlertGroupingSettingIntelligentConfig{TimeWindow: 900, IAGFields: []string{"summary"}},
+		Services: []*ServiceReference{{ID: "PSVC1"}},
+	}
+
+	mux.HandleFunc("/alert_grouping_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"alert_grouping_setting":{"name":"Intelligent","type":"intelligent","config":{"time_window":900,"iag_fields":["summary"]},"services":[{"id":"PSVC1"}]}}`)
+		w.Write([]byte(`{"alert_grouping_setting": {"id": "PAGS1", "name": "Intelligent", "type": "intelligent", "config": {"time_window": 900, "iag_fields": ["summary"]}, "services": [{"id": "PSVC1"}]}}`))
+	})
+
+	resp, _, err := client.AlertGroupingSettings.Create(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := *input
+	want.ID = "PAGS1"
+
+	if !reflect.DeepEqual(resp, &want) {
+		t.Errorf("returned %#v; want %#v", resp, &want)
+	}
+}
+
+func TestAlertGroupingSettingsGetUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/alert_grouping_settings/PAGS1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"alert_grouping_setting": {"id": "PAGS1", "type": "time", "config": {"timeout": 0}}}`))
+		case "PUT":
+			testBody(t, r, `{"alert_grouping_setting":{"type":"time","config":{"timeout":120}}}`)
+			w.Write([]byte(`{"alert_grouping_setting": {"id": "PAGS1", "type": "time", "config": {"timeout": 120}}}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	resp, _, err := client.AlertGroupingSettings.Get("PAGS1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&AlertGroupingSetting{ID: "PAGS1", Type: AlertGroupingSettingTypeTime, Config: &AlertGroupingSettingTimeConfig{}}); !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	resp, _, err = client.AlertGroupingSettings.Update("PAGS1", &AlertGroupingSetting{
+		Type:   AlertGroupingSettingTypeTime,
+		Config: &AlertGroupingSettingTimeConfig{Timeout: 120},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&AlertGroupingSetting{ID: "PAGS1", Type: AlertGroupingSettingTypeTime, Config: &AlertGroupingSettingTimeConfig{Timeout: 120}}); !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	if _, err := client.AlertGroupingSettings.Delete("PAGS1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAlertGroupingSettingUnknownType(t *testing.T) {
+	var a AlertGroupingSetting
+	if err := json.Unmarshal([]byte(`{"id": "PAGS1", "type": "future", "config": {"x": 1}}`), &a); err != nil {
+		t.Fatal(err)
+	}
+
+	want := AlertGroupingSettingRawConfig(`{"x": 1}`)
+	if !reflect.DeepEqual(a.Config, want) {
+		t.Errorf("config %#v; want %#v", a.Config, want)
+	}
+
+	b, err := json.Marshal(&a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"id":"PAGS1","type":"future","config":{"x":1}}` {
+		t.Errorf("marshaled %s", b)
+	}
+}
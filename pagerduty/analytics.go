@@ -0,0 +1,279 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AnalyticsService handles the communication with analytics
+// related methods of the PagerDuty API.
+type AnalyticsService service
+
+// AnalyticsAggregateUnit is the unit of time aggregated metrics are grouped by.
+type AnalyticsAggregateUnit string
+
+const (
+	AnalyticsAggregateUnitDay   AnalyticsAggregateUnit = "day"
+	AnalyticsAggregateUnitWeek  AnalyticsAggregateUnit = "week"
+	AnalyticsAggregateUnitMonth AnalyticsAggregateUnit = "month"
+)
+
+// AnalyticsFilter represents the filters applied to analytics requests.
+// CreatedAtStart and CreatedAtEnd are ISO 8601 timestamps and can be set
+// from time values with SetCreatedAtRange.
+type AnalyticsFilter struct {
+	CreatedAtStart      string   `json:"created_at_start,omitempty"`
+	CreatedAtEnd        string   `json:"created_at_end,omitempty"`
+	Urgency             string   `json:"urgency,omitempty"`
+	Major               *bool    `json:"major,omitempty"`
+	TeamIDs             []string `json:"team_ids,omitempty"`
+	ServiceIDs          []string `json:"service_ids,omitempty"`
+	PriorityIDs         []string `json:"priority_ids,omitempty"`
+	PriorityNames       []string `json:"priority_names,omitempty"`
+	EscalationPolicyIDs []string `json:"escalation_policy_ids,omitempty"`
+}
+
+// SetCreatedAtRange sets the range of incident creation times to include.
+func (f *AnalyticsFilter) SetCreatedAtRange(start, end time.Time) {
+	f.CreatedAtStart = start.Format(time.RFC3339)
+	f.CreatedAtEnd = end.Format(time.RFC3339)
+}
+
+// AnalyticsMetricsRequest represents a request for aggregated incident metrics.
+type AnalyticsMetricsRequest struct {
+	Filters       *AnalyticsFilter       `json:"filters,omitempty"`
+	AggregateUnit AnalyticsAggregateUnit `json:"aggregate_unit,omitempty"`
+	TimeZone      string                 `json:"time_zone,omitempty"`
+}
+
+// AnalyticsMetricsResponse represents a response of aggregated incident metrics.
+type AnalyticsMetricsResponse struct {
+	Data          []*AnalyticsIncidentMetrics `json:"data,omitempty"`
+	Filters       *AnalyticsFilter            `json:"filters,omitempty"`
+	AggregateUnit AnalyticsAggregateUnit      `json:"aggregate_unit,omitempty"`
+	TimeZone      string                      `json:"time_zone,omitempty"`
+}
+
+// AnalyticsIncidentMetrics represents incident metrics aggregated over the
+// account or a single service, team or escalation policy.
+type AnalyticsIncidentMetrics struct {
+	ServiceID                      string  `json:"service_id,omitempty"`
+	ServiceName                    string  `json:"service_name,omitempty"`
+	TeamID                         string  `json:"team_id,omitempty"`
+	TeamName                       string  `json:"team_name,omitempty"`
+	EscalationPolicyID             string  `json:"escalation_policy_id,omitempty"`
+	EscalationPolicyName           string  `json:"escalation_policy_name,omitempty"`
+	RangeStart                     string  `json:"range_start,omitempty"`
+	MeanAssignmentCount            float64 `json:"mean_assignment_count,omitempty"`
+	MeanEngagedSeconds             float64 `json:"mean_engaged_seconds,omitempty"`
+	MeanEngagedUserCount           float64 `json:"mean_engaged_user_count,omitempty"`
+	MeanSecondsToEngage            float64 `json:"mean_seconds_to_engage,omitempty"`
+	MeanSecondsToFirstAck          float64 `json:"mean_seconds_to_first_ack,omitempty"`
+	MeanSecondsToMobilize          float64 `json:"mean_seconds_to_mobilize,omitempty"`
+	MeanSecondsToResolve           float64 `json:"mean_seconds_to_resolve,omitempty"`
+	TotalBusinessHourInterruptions int     `json:"total_business_hour_interruptions,omitempty"`
+	TotalEngagedSeconds            int     `json:"total_engaged_seconds,omitempty"`
+	TotalEscalationCount           int     `json:"total_escalation_count,omitempty"`
+	TotalIncidentCount             int     `json:"total_incident_count,omitempty"`
+	TotalIncidentsAcknowledged     int     `json:"total_incidents_acknowledged,omitempty"`
+	TotalIncidentsAutoResolved     int     `json:"total_incidents_auto_resolved,omitempty"`
+	TotalIncidentsManualEscalated  int     `json:"total_incidents_manual_escalated,omitempty"`
+	TotalIncidentsReassigned       int     `json:"total_incidents_reassigned,omitempty"`
+	TotalIncidentsTimeoutEscalated int     `json:"total_incidents_timeout_escalated,omitempty"`
+	TotalInterruptions             int     `json:"total_interruptions,omitempty"`
+	TotalMajorIncidents            int     `json:"total_major_incidents,omitempty"`
+	TotalNotifications             int     `json:"total_notifications,omitempty"`
+	TotalOffHourInterruptions      int     `json:"total_off_hour_interruptions,omitempty"`
+	TotalSleepHourInterruptions    int     `json:"total_sleep_hour_interruptions,omitempty"`
+	TotalSnoozedSeconds            int     `json:"total_snoozed_seconds,omitempty"`
+	UpTimePct                      float64 `json:"up_time_pct,omitempty"`
+}
+
+// AnalyticsRawIncidentsRequest represents a request for raw incident
+// analytics data. Results are paged by passing the Last value of a response
+// as StartingAfter.
+type AnalyticsRawIncidentsRequest struct {
+	Filters       *AnalyticsFilter `json:"filters,omitempty"`
+	Limit         int              `json:"limit,omitempty"`
+	StartingAfter string           `json:"starting_after,omitempty"`
+	EndingBefore  string           `json:"ending_before,omitempty"`
+	Order         string           `json:"order,omitempty"`
+	OrderBy       string           `json:"order_by,omitempty"`
+	TimeZone      string           `json:"time_zone,omitempty"`
+}
+
+// AnalyticsRawIncidentsResponse represents a page of raw incident analytics data.
+type AnalyticsRawIncidentsResponse struct {
+	Data          []*AnalyticsRawIncident `json:"data,omitempty"`
+	First         string                  `json:"first,omitempty"`
+	Last          string                  `json:"last,omitempty"`
+	More          bool                    `json:"more,omitempty"`
+	Limit         int                     `json:"limit,omitempty"`
+	Order         string                  `json:"order,omitempty"`
+	OrderBy       string                  `json:"order_by,omitempty"`
+	StartingAfter string                  `json:"starting_after,omitempty"`
+	EndingBefore  string                  `json:"ending_before,omitempty"`
+	Filters       *AnalyticsFilter        `json:"filters,omitempty"`
+	TimeZone      string                  `json:"time_zone,omitempty"`
+}
+
+// AnalyticsRawIncident represents the analytics data of a single incident.
+type AnalyticsRawIncident struct {
+	ID                        string `json:"id,omitempty"`
+	IncidentNumber            int    `json:"incident_number,omitempty"`
+	Description               string `json:"description,omitempty"`
+	CreatedAt                 string `json:"created_at,omitempty"`
+	ResolvedAt                string `json:"resolved_at,omitempty"`
+	Urgency                   string `json:"urgency,omitempty"`
+	Major                     bool   `json:"major,omitempty"`
+	PriorityID                string `json:"priority_id,omitempty"`
+	PriorityName              string `json:"priority_name,omitempty"`
+	ServiceID                 string `json:"service_id,omitempty"`
+	ServiceName               string `json:"service_name,omitempty"`
+	TeamID                    string `json:"team_id,omitempty"`
+	TeamName                  string `json:"team_name,omitempty"`
+	EscalationPolicyID        string `json:"escalation_policy_id,omitempty"`
+	EscalationPolicyName      string `json:"escalation_policy_name,omitempty"`
+	AssignmentCount           int    `json:"assignment_count,omitempty"`
+	BusinessHourInterruptions int    `json:"business_hour_interruptions,omitempty"`
+	EngagedSeconds            int    `json:"engaged_seconds,omitempty"`
+	EngagedUserCount          int    `json:"engaged_user_count,omitempty"`
+	EscalationCount           int    `json:"escalation_count,omitempty"`
+	OffHourInterruptions      int    `json:"off_hour_interruptions,omitempty"`
+	SecondsToEngage           int    `json:"seconds_to_engage,omitempty"`
+	SecondsToFirstAck         int    `json:"seconds_to_first_ack,omitempty"`
+	SecondsToMobilize         int    `json:"seconds_to_mobilize,omitempty"`
+	SecondsToResolve          int    `json:"seconds_to_resolve,omitempty"`
+	SleepHourInterruptions    int    `json:"sleep_hour_interruptions,omitempty"`
+	SnoozedSeconds            int    `json:"snoozed_seconds,omitempty"`
+	UserDefinedEffortSeconds  int    `json:"user_defined_effort_seconds,omitempty"`
+}
+
+// analyticsEarlyAccessHeader opts requests into the current version of the
+// analytics API.
+var analyticsEarlyAccessHeader = RequestOptions{
+	Type:  "header",
+	Label: "X-EARLY-ACCESS",
+	Value: "analytics-v2",
+}
+
+func validateAnalyticsTimeZone(tz string) error {
+	if tz == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return fmt.Errorf("invalid analytics time zone %q: %v", tz, err)
+	}
+	return nil
+}
+
+func (r *AnalyticsMetricsRequest) validate() error {
+	switch r.AggregateUnit {
+	case "", AnalyticsAggregateUnitDay, AnalyticsAggregateUnitWeek, AnalyticsAggregateUnitMonth:
+	default:
+		return fmt.Errorf("invalid analytics aggregate unit %q", r.AggregateUnit)
+	}
+	return validateAnalyticsTimeZone(r.TimeZone)
+}
+
+// GetAggregatedIncidentMetrics gets incident metrics aggregated over the account.
+func (s *AnalyticsService) GetAggregatedIncidentMetrics(r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	return s.GetAggregatedIncidentMetricsContext(context.Background(), r)
+}
+
+// GetAggregatedIncidentMetricsContext gets incident metrics aggregated over the account.
+func (s *AnalyticsService) GetAggregatedIncidentMetricsContext(ctx context.Context, r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	return s.getAggregatedMetrics(ctx, "/analytics/metrics/incidents/all", r)
+}
+
+// GetAggregatedServiceMetrics gets incident metrics aggregated by service.
+func (s *AnalyticsService) GetAggregatedServiceMetrics(r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	return s.GetAggregatedServiceMetricsContext(context.Background(), r)
+}
+
+// GetAggregatedServiceMetricsContext gets incident metrics aggregated by service.
+func (s *AnalyticsService) GetAggregatedServiceMetricsContext(ctx context.Context, r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	return s.getAggregatedMetrics(ctx, "/analytics/metrics/incidents/services", r)
+}
+
+// GetAggregatedTeamMetrics gets incident metrics aggregated by team.
+func (s *AnalyticsService) GetAggregatedTeamMetrics(r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	return s.GetAggregatedTeamMetricsContext(context.Background(), r)
+}
+
+// GetAggregatedTeamMetricsContext gets incident metrics aggregated by team.
+func (s *AnalyticsService) GetAggregatedTeamMetricsContext(ctx context.Context, r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	return s.getAggregatedMetrics(ctx, "/analytics/metrics/incidents/teams", r)
+}
+
+// GetAggregatedEscalationPolicyMetrics gets incident metrics aggregated by escalation policy.
+func (s *AnalyticsService) GetAggregatedEscalationPolicyMetrics(r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	return s.GetAggregatedEscalationPolicyMetricsContext(context.Background(), r)
+}
+
+// GetAggregatedEscalationPolicyMetricsContext gets incident metrics aggregated by escalation policy.
+func (s *AnalyticsService) GetAggregatedEscalationPolicyMetricsContext(ctx context.Context, r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	return s.getAggregatedMetrics(ctx, "/analytics/metrics/incidents/escalation_policies", r)
+}
+
+func (s *AnalyticsService) getAggregatedMetrics(ctx context.Context, u string, r *AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error) {
+	if r == nil {
+		r = &AnalyticsMetricsRequest{}
+	}
+	if err := r.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	v := new(AnalyticsMetricsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, r, v, analyticsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// ListRawIncidents lists a page of raw analytics data for incidents.
+func (s *AnalyticsService) ListRawIncidents(r *AnalyticsRawIncidentsRequest) (*AnalyticsRawIncidentsResponse, *Response, error) {
+	return s.ListRawIncidentsContext(context.Background(), r)
+}
+
+// ListRawIncidentsContext lists a page of raw analytics data for incidents.
+func (s *AnalyticsService) ListRawIncidentsContext(ctx context.Context, r *AnalyticsRawIncidentsRequest) (*AnalyticsRawIncidentsResponse, *Response, error) {
+	u := "/analytics/raw/incidents"
+	v := new(AnalyticsRawIncidentsResponse)
+
+	if r == nil {
+		r = &AnalyticsRawIncidentsRequest{}
+	}
+	if err := validateAnalyticsTimeZone(r.TimeZone); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, r, v, analyticsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetRawIncident gets the raw analytics data of a single incident.
+func (s *AnalyticsService) GetRawIncident(id string) (*AnalyticsRawIncident, *Response, error) {
+	return s.GetRawIncidentContext(context.Background(), id)
+}
+
+// GetRawIncidentContext gets the raw analytics data of a single incident.
+func (s *AnalyticsService) GetRawIncidentContext(ctx context.Context, id string) (*AnalyticsRawIncident, *Response, error) {
+	u := fmt.Sprintf("/analytics/raw/incidents/%s", id)
+	v := new(AnalyticsRawIncident)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, nil, nil, v, analyticsEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,136 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAnalyticsGetAggregatedMetrics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	filters := &AnalyticsFilter{ServiceIDs: []string{"PSVC1"}}
+	filters.SetCreatedAtRange(
+		time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+	)
+	req := &AnalyticsMetricsRequest{
+		Filters:       filters,
+		AggregateUnit: AnalyticsAggregateUnitWeek,
+		TimeZone:      "Europe/Stockholm",
+	}
+
+	for _, path := range []string{"all", "services", "teams", "escalation_policies"} {
+		mux.HandleFunc("/analytics/metrics/incidents/"+path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testHeader(t, r, "X-EARLY-ACCESS", "analytics-v2")
+			testBody(t, r, `{"filters":{"created_at_start":"2023-01-01T00:00:00Z","created_at_end":"2023-02-01T00:00:00Z","service_ids":["PSVC1"]},"aggregate_unit":"week","time_zone":"Europe/Stockholm"}`)
+			w.Write([]byte(`{"data": [{"service_id": "PSVC1", "range_start": "2023-01-02T00:00:00+01:00", "mean_seconds_to_resolve": 120.5, "mean_seconds_to_first_ack": 30, "total_incident_count": 4}], "aggregate_unit": "week", "time_zone": "Europe/Stockholm"}`))
+		})
+	}
+
+	getters := map[string]func(*AnalyticsMetricsRequest) (*AnalyticsMetricsResponse, *Response, error){
+		"all":                 client.Analytics.GetAggregatedIncidentMetrics,
+		"services":            client.Analytics.GetAggregatedServiceMetrics,
+		"teams":               client.Analytics.GetAggregatedTeamMetrics,
+		"escalation policies": client.Analytics.GetAggregatedEscalationPolicyMetrics,
+	}
+
+	want := &AnalyticsMetricsResponse{
+		Data: []*AnalyticsIncidentMetrics{
+			{
+				ServiceID:             "PSVC1",
+				RangeStart:            "2023-01-02T00:00:00+01:00",
+				MeanSecondsToResolve:  120.5,
+				MeanSecondsToFirstAck: 30,
+				TotalIncidentCount:    4,
+			},
+		},
+		AggregateUnit: AnalyticsAggregateUnitWeek,
+		TimeZone:      "Europe/Stockholm",
+	}
+
+	for name, get := range getters {
+		resp, _, err := get(req)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if !reflect.DeepEqual(resp, want) {
+			t.Errorf("%s returned %#v; want %#v", name, resp, want)
+		}
+	}
+}
+
+func TestAnalyticsGetAggregatedMetricsValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/analytics/metrics/incidents/all", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("invalid requests should not be sent")
+	})
+
+	if _, _, err := client.Analytics.GetAggregatedIncidentMetrics(&AnalyticsMetricsRequest{AggregateUnit: "year"}); err == nil {
+		t.Error("expected an error for an invalid aggregate unit")
+	}
+	if _, _, err := client.Analytics.GetAggregatedIncidentMetrics(&AnalyticsMetricsRequest{TimeZone: "Mars/Olympus"}); err == nil {
+		t.Error("expected an error for an invalid time zone")
+	}
+}
+
+func TestAnalyticsListRawIncidents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/analytics/raw/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "X-EARLY-ACCESS", "analytics-v2")
+		testBody(t, r, `{"filters":{"urgency":"high"},"limit":1,"starting_after":"abc"}`)
+		w.Write([]byte(`{"data": [{"id": "PINC1", "seconds_to_resolve": 300, "urgency": "high"}], "first": "def", "last": "def", "more": true, "limit": 1}`))
+	})
+
+	resp, _, err := client.Analytics.ListRawIncidents(&AnalyticsRawIncidentsRequest{
+		Filters:       &AnalyticsFilter{Urgency: "high"},
+		Limit:         1,
+		StartingAfter: "abc",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &AnalyticsRawIncidentsResponse{
+		Data:  []*AnalyticsRawIncident{{ID: "PINC1", SecondsToResolve: 300, Urgency: "high"}},
+		First: "def",
+		Last:  "def",
+		More:  true,
+		Limit: 1,
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestAnalyticsGetRawIncident(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/analytics/raw/incidents/PINC1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-EARLY-ACCESS", "analytics-v2")
+		w.Write([]byte(`{"id": "PINC1", "incident_number": 7, "seconds_to_first_ack": 12}`))
+	})
+
+	resp, _, err := client.Analytics.GetRawIncident("PINC1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &AnalyticsRawIncident{ID: "PINC1", IncidentNumber: 7, SecondsToFirstAck: 12}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
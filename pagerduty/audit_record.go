@@ -0,0 +1,253 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// AuditRecordService handles the communication with audit record
+// related methods of the PagerDuty API.
+type AuditRecordService service
+
+// AuditRecordAction is the action performed on the root resource of an
+// audit record.
+type AuditRecordAction string
+
+const (
+	AuditRecordActionCreate AuditRecordAction = "create"
+	AuditRecordActionUpdate AuditRecordAction = "update"
+	AuditRecordActionDelete AuditRecordAction = "delete"
+)
+
+// AuditRecord represents a change made to a PagerDuty resource.
+type AuditRecord struct {
+	ID               string                       `json:"id,omitempty"`
+	Self             string                       `json:"self,omitempty"`
+	ExecutionTime    string                       `json:"execution_time,omitempty"`
+	ExecutionContext *AuditRecordExecutionContext `json:"execution_context,omitempty"`
+	Actors           []*AgentReference            `json:"actors,omitempty"`
+	Method           *AuditRecordMethod           `json:"method,omitempty"`
+	RootResource     *AuditRecordResource         `json:"root_resource,omitempty"`
+	Action           AuditRecordAction            `json:"action,omitempty"`
+	Details          *AuditRecordDetails          `json:"details,omitempty"`
+}
+
+// AuditRecordExecutionContext represents the request context in which the
+// audited action was executed.
+type AuditRecordExecutionContext struct {
+	RequestID     string `json:"request_id,omitempty"`
+	RemoteAddress string `json:"remote_address,omitempty"`
+}
+
+// AuditRecordMethod represents how the actors were authenticated when
+// performing the audited action.
+type AuditRecordMethod struct {
+	Type           string `json:"type,omitempty"`
+	TruncatedToken string `json:"truncated_token,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+// AuditRecordResource represents the resource an audit record is about.
+type AuditRecordResource resourceReference
+
+// AuditRecordDetails represents the changes recorded by an audit record.
+type AuditRecordDetails struct {
+	Resource   *AuditRecordResource          `json:"resource,omitempty"`
+	Fields     []*AuditRecordFieldChange     `json:"fields,omitempty"`
+	References []*AuditRecordReferenceChange `json:"references,omitempty"`
+}
+
+// AuditRecordFieldChange represents a change to a field of a resource.
+type AuditRecordFieldChange struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value,omitempty"`
+	BeforeValue string `json:"before_value,omitempty"`
+}
+
+// AuditRecordReferenceChange represents references added to or removed from
+// a resource.
+type AuditRecordReferenceChange struct {
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Added       []*AuditRecordResource `json:"added,omitempty"`
+	Removed     []*AuditRecordResource `json:"removed,omitempty"`
+}
+
+// ListAuditRecordsResponse represents a list response of audit records.
+type ListAuditRecordsResponse struct {
+	Records    []*AuditRecord `json:"records,omitempty"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	Limit      int            `json:"limit,omitempty"`
+}
+
+// ListAuditRecordsOptions represents options when listing the audit records
+// of the account.
+type ListAuditRecordsOptions struct {
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+	Since                string   `url:"since,omitempty"`
+	Until                string   `url:"until,omitempty"`
+	RootResourceTypes    []string `url:"root_resource_types,omitempty,brackets"`
+	ActorType            string   `url:"actor_type,omitempty"`
+	ActorID              string   `url:"actor_id,omitempty"`
+	MethodType           string   `url:"method_type,omitempty"`
+	MethodTruncatedToken string   `url:"method_truncated_token,omitempty"`
+	Actions              []string `url:"actions,omitempty,brackets"`
+}
+
+type listAuditRecordsOptionsGen struct {
+	options *ListAuditRecordsOptions
+}
+
+func (o *listAuditRecordsOptionsGen) currentCursor() string {
+	return o.options.Cursor
+}
+
+func (o *listAuditRecordsOptionsGen) changeCursor(s string) {
+	o.options.Cursor = s
+}
+
+func (o *listAuditRecordsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListResourceAuditRecordsOptions represents options when listing the audit
+// records of a single resource.
+type ListResourceAuditRecordsOptions struct {
+	Limit  int    `url:"limit,omitempty"`
+	Cursor string `url:"cursor,omitempty"`
+	Since  string `url:"since,omitempty"`
+	Until  string `url:"until,omitempty"`
+}
+
+type listResourceAuditRecordsOptionsGen struct {
+	options *ListResourceAuditRecordsOptions
+}
+
+func (o *listResourceAuditRecordsOptionsGen) currentCursor() string {
+	return o.options.Cursor
+}
+
+func (o *listResourceAuditRecordsOptionsGen) changeCursor(s string) {
+	o.options.Cursor = s
+}
+
+func (o *listResourceAuditRecordsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// List lists the audit records of the account. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, all audit records matching the options will be returned.
+func (s *AuditRecordService) List(o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists the audit records of the account. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, all audit records matching the options will be returned.
+func (s *AuditRecordService) ListContext(ctx context.Context, o *ListAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	if o == nil {
+		o = &ListAuditRecordsOptions{}
+	}
+
+	return s.list(ctx, "/audit/records", o.Limit, &listAuditRecordsOptionsGen{options: o})
+}
+
+// ListForUser lists the audit records of a user.
+func (s *AuditRecordService) ListForUser(id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListForUserContext(context.Background(), id, o)
+}
+
+// ListForUserContext lists the audit records of a user.
+func (s *AuditRecordService) ListForUserContext(ctx context.Context, id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.listForResource(ctx, fmt.Sprintf("/users/%s/audit/records", id), o)
+}
+
+// ListForService lists the audit records of a service.
+func (s *AuditRecordService) ListForService(id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListForServiceContext(context.Background(), id, o)
+}
+
+// ListForServiceContext lists the audit records of a service.
+func (s *AuditRecordService) ListForServiceContext(ctx context.Context, id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.listForResource(ctx, fmt.Sprintf("/services/%s/audit/records", id), o)
+}
+
+// ListForSchedule lists the audit records of a schedule.
+func (s *AuditRecordService) ListForSchedule(id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListForScheduleContext(context.Background(), id, o)
+}
+
+// ListForScheduleContext lists the audit records of a schedule.
+func (s *AuditRecordService) ListForScheduleContext(ctx context.Context, id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.listForResource(ctx, fmt.Sprintf("/schedules/%s/audit/records", id), o)
+}
+
+// ListForEscalationPolicy lists the audit records of an escalation policy.
+func (s *AuditRecordService) ListForEscalationPolicy(id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListForEscalationPolicyContext(context.Background(), id, o)
+}
+
+// ListForEscalationPolicyContext lists the audit records of an escalation policy.
+func (s *AuditRecordService) ListForEscalationPolicyContext(ctx context.Context, id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.listForResource(ctx, fmt.Sprintf("/escalation_policies/%s/audit/records", id), o)
+}
+
+// ListForTeam lists the audit records of a team.
+func (s *AuditRecordService) ListForTeam(id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.ListForTeamContext(context.Background(), id, o)
+}
+
+// ListForTeamContext lists the audit records of a team.
+func (s *AuditRecordService) ListForTeamContext(ctx context.Context, id string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	return s.listForResource(ctx, fmt.Sprintf("/teams/%s/audit/records", id), o)
+}
+
+func (s *AuditRecordService) listForResource(ctx context.Context, u string, o *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error) {
+	if o == nil {
+		o = &ListResourceAuditRecordsOptions{}
+	}
+
+	return s.list(ctx, u, o.Limit, &listResourceAuditRecordsOptionsGen{options: o})
+}
+
+func (s *AuditRecordService) list(ctx context.Context, u string, limit int, gen cursorQueryOptionsGen) (*ListAuditRecordsResponse, *Response, error) {
+	v := new(ListAuditRecordsResponse)
+
+	if limit != 0 {
+		resp, err := s.client.newRequestDoContext(ctx, "GET", u, gen.buildStruct(), nil, v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	records := make([]*AuditRecord, 0)
+
+	// Create a handler closure capable of parsing data from the audit records
+	// endpoint and appending resultant records to the return slice.
+	responseHandler := func(response *Response) (CursorListResp, *Response, error) {
+		var result ListAuditRecordsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return CursorListResp{}, response, err
+		}
+
+		records = append(records, result.Records...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return CursorListResp{
+			Limit:      result.Limit,
+			NextCursor: result.NextCursor,
+		}, response, nil
+	}
+	err := s.client.newRequestCursorPagedGetQueryDoContext(ctx, u, responseHandler, gen)
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Records = records
+
+	return v, nil, nil
+}
@@ -0,0 +1,127 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAuditRecordsList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"records": [{
+				"id": "PREC1",
+				"execution_time": "2023-01-01T00:00:00Z",
+				"actors": [{"id": "PUSER1", "type": "user_reference"}],
+				"method": {"type": "api_token", "truncated_token": "abc"},
+				"root_resource": {"id": "PSVC1", "type": "service_reference"},
+				"action": "update",
+				"details": {
+					"fields": [{"name": "name", "value": "new", "before_value": "old"}],
+					"references": [{"name": "teams", "added": [{"id": "PTEAM1", "type": "team_reference"}]}]
+				}
+			}], "limit": 1, "next_cursor": "next"}`))
+		case "next":
+			w.Write([]byte(`{"records": [{"id": "PREC2", "action": "create"}], "limit": 1}`))
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	resp, _, err := client.AuditRecords.List(&ListAuditRecordsOptions{Actions: []string{"create", "update"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAuditRecordsResponse{
+		Records: []*AuditRecord{
+			{
+				ID:            "PREC1",
+				ExecutionTime: "2023-01-01T00:00:00Z",
+				Actors:        []*AgentReference{{ID: "PUSER1", Type: "user_reference"}},
+				Method:        &AuditRecordMethod{Type: "api_token", TruncatedToken: "abc"},
+				RootResource:  &AuditRecordResource{ID: "PSVC1", Type: "service_reference"},
+				Action:        AuditRecordActionUpdate,
+				Details: &AuditRecordDetails{
+					Fields: []*AuditRecordFieldChange{{Name: "name", Value: "new", BeforeValue: "old"}},
+					References: []*AuditRecordReferenceChange{
+						{Name: "teams", Added: []*AuditRecordResource{{ID: "PTEAM1", Type: "team_reference"}}},
+					},
+				},
+			},
+			{
+				ID:     "PREC2",
+				Action: AuditRecordActionCreate,
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestAuditRecordsListSinglePage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/audit/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "limit", "1")
+		w.Write([]byte(`{"records": [{"id": "PREC1"}], "limit": 1, "next_cursor": "next"}`))
+	})
+
+	resp, _, err := client.AuditRecords.List(&ListAuditRecordsOptions{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAuditRecordsResponse{
+		Records:    []*AuditRecord{{ID: "PREC1"}},
+		Limit:      1,
+		NextCursor: "next",
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestAuditRecordsListForResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, path := range []string{"users", "services", "schedules", "escalation_policies", "teams"} {
+		mux.HandleFunc("/"+path+"/P1/audit/records", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testQueryValue(t, r, "since", "2023-01-01T00:00:00Z")
+			w.Write([]byte(`{"records": [{"id": "PREC1"}], "limit": 25}`))
+		})
+	}
+
+	o := &ListResourceAuditRecordsOptions{Since: "2023-01-01T00:00:00Z"}
+	listers := map[string]func(string, *ListResourceAuditRecordsOptions) (*ListAuditRecordsResponse, *Response, error){
+		"user":              client.AuditRecords.ListForUser,
+		"service":           client.AuditRecords.ListForService,
+		"schedule":          client.AuditRecords.ListForSchedule,
+		"escalation policy": client.AuditRecords.ListForEscalationPolicy,
+		"team":              client.AuditRecords.ListForTeam,
+	}
+
+	for name, list := range listers {
+		resp, _, err := list("P1", o)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		want := &ListAuditRecordsResponse{Records: []*AuditRecord{{ID: "PREC1"}}}
+		if !reflect.DeepEqual(resp, want) {
+			t.Errorf("%s returned %#v; want %#v", name, resp, want)
+		}
+	}
+}
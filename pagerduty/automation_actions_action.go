@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // AutomationActionsAction handles the communication with Automation Actions
 // related methods of the PagerDuty API.
@@ -47,8 +50,101 @@ type AutomationActionsActionServiceAssociationPayload struct {
 	Service *ServiceReference `json:"service,omitempty"`
 }
 
+// ListAutomationActionsActionsOptions represents options when listing
+// automation actions.
+type ListAutomationActionsActionsOptions struct {
+	Limit          int    `url:"limit,omitempty"`
+	Cursor         string `url:"cursor,omitempty"`
+	Name           string `url:"name,omitempty"`
+	RunnerID       string `url:"runner_id,omitempty"`
+	ServiceID      string `url:"service_id,omitempty"`
+	TeamID         string `url:"team_id,omitempty"`
+	Classification string `url:"classification,omitempty"`
+}
+
+// ListAutomationActionsActionsResponse represents a list response of
+// automation actions.
+type ListAutomationActionsActionsResponse struct {
+	Actions    []*AutomationActionsAction   `json:"actions,omitempty"`
+	Privileges *AutomationActionsPrivileges `json:"privileges,omitempty"`
+	NextCursor string                       `json:"next_cursor,omitempty"`
+	Limit      int                          `json:"limit,omitempty"`
+}
+
 var automationActionsActionBaseUrl = "/automation_actions/actions"
 
+type listAutomationActionsActionOptionsGen struct {
+	options *ListAutomationActionsActionsOptions
+}
+
+func (o *listAutomationActionsActionOptionsGen) currentCursor() string {
+	return o.options.Cursor
+}
+
+func (o *listAutomationActionsActionOptionsGen) changeCursor(s string) {
+	o.options.Cursor = s
+}
+
+func (o *listAutomationActionsActionOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// List lists existing actions. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of actions will be returned.
+func (s *AutomationActionsActionService) List(o *ListAutomationActionsActionsOptions) (*ListAutomationActionsActionsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing actions. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of actions will be returned.
+func (s *AutomationActionsActionService) ListContext(ctx context.Context, o *ListAutomationActionsActionsOptions) (*ListAutomationActionsActionsResponse, *Response, error) {
+	u := automationActionsActionBaseUrl
+	v := new(ListAutomationActionsActionsResponse)
+
+	if o == nil {
+		o = &ListAutomationActionsActionsOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	items := make([]*AutomationActionsAction, 0)
+
+	// Create a handler closure capable of parsing data from the actions
+	// endpoint and appending resultant actions to the return slice.
+	responseHandler := func(response *Response) (CursorListResp, *Response, error) {
+		var result ListAutomationActionsActionsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return CursorListResp{}, response, err
+		}
+
+		items = append(items, result.Actions...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return CursorListResp{
+			Limit:      result.Limit,
+			NextCursor: result.NextCursor,
+		}, response, nil
+	}
+	err := s.client.newRequestCursorPagedGetQueryDoContext(ctx, u, responseHandler, &listAutomationActionsActionOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Actions = items
+
+	return v, nil, nil
+}
+
 // Create creates a new action
 func (s *AutomationActionsActionService) Create(action *AutomationActionsAction) (*AutomationActionsAction, *Response, error) {
 	u := automationActionsActionBaseUrl
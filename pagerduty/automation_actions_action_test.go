@@ -496,3 +496,41 @@ func TestAutomationActionsActionServiceAssociationGet(t *testing.T) {
 		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
 	}
 }
+
+func TestAutomationActionsActionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/automation_actions/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "runner_id", "01DF4OBNYKW6FMQEDIMPPA9ZEA")
+		testQueryValue(t, r, "classification", "diagnostic")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"actions": [{"id": "01DA2MLYN0J5EFC1LKWXUKDDKT", "name": "Restart"}], "limit": 1, "next_cursor": "abc"}`))
+		case "abc":
+			w.Write([]byte(`{"actions": [{"id": "01DA2MLYN0J5EFC1LKWXUKDDKU", "name": "Diagnose"}], "limit": 1}`))
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	resp, _, err := client.AutomationActionsAction.List(&ListAutomationActionsActionsOptions{
+		RunnerID:       "01DF4OBNYKW6FMQEDIMPPA9ZEA",
+		Classification: "diagnostic",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAutomationActionsActionsResponse{
+		Actions: []*AutomationActionsAction{
+			{ID: "01DA2MLYN0J5EFC1LKWXUKDDKT", Name: "Restart"},
+			{ID: "01DA2MLYN0J5EFC1LKWXUKDDKU", Name: "Diagnose"},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
@@ -0,0 +1,199 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AutomationActionsInvocationState is the state of an automation action
+// invocation.
+type AutomationActionsInvocationState string
+
+const (
+	AutomationActionsInvocationStatePrepared  AutomationActionsInvocationState = "prepared"
+	AutomationActionsInvocationStateCreated   AutomationActionsInvocationState = "created"
+	AutomationActionsInvocationStateSent      AutomationActionsInvocationState = "sent"
+	AutomationActionsInvocationStateQueued    AutomationActionsInvocationState = "queued"
+	AutomationActionsInvocationStateRunning   AutomationActionsInvocationState = "running"
+	AutomationActionsInvocationStateAborted   AutomationActionsInvocationState = "aborted"
+	AutomationActionsInvocationStateCompleted AutomationActionsInvocationState = "completed"
+	AutomationActionsInvocationStateError     AutomationActionsInvocationState = "error"
+	AutomationActionsInvocationStateUnknown   AutomationActionsInvocationState = "unknown"
+)
+
+// IsTerminal reports whether an invocation in this state has finished and
+// will not change state anymore.
+func (st AutomationActionsInvocationState) IsTerminal() bool {
+	switch st {
+	case AutomationActionsInvocationStateAborted,
+		AutomationActionsInvocationStateCompleted,
+		AutomationActionsInvocationStateError:
+		return true
+	}
+	return false
+}
+
+// AutomationActionsInvocation represents a run of an automation action.
+type AutomationActionsInvocation struct {
+	ID             string                                     `json:"id,omitempty"`
+	Type           string                                     `json:"type,omitempty"`
+	ActionID       string                                     `json:"action_id,omitempty"`
+	ActionSnapshot *AutomationActionsInvocationActionSnapshot `json:"action_snapshot,omitempty"`
+	RunnerID       string                                     `json:"runner_id,omitempty"`
+	State          AutomationActionsInvocationState           `json:"state,omitempty"`
+	Timing         []*AutomationActionsInvocationTiming       `json:"timing,omitempty"`
+	Duration       int                                        `json:"duration,omitempty"`
+	Metadata       *AutomationActionsInvocationMetadata       `json:"metadata,omitempty"`
+}
+
+// AutomationActionsInvocationActionSnapshot represents the action as it was
+// when the invocation was created.
+type AutomationActionsInvocationActionSnapshot struct {
+	Name string `json:"name,omitempty"`
+}
+
+// AutomationActionsInvocationTiming represents the time at which an
+// invocation entered a state.
+type AutomationActionsInvocationTiming struct {
+	State             AutomationActionsInvocationState `json:"state,omitempty"`
+	CreationTimestamp string                           `json:"creation_timestamp,omitempty"`
+}
+
+// AutomationActionsInvocationMetadata represents who invoked an action and
+// on which incident.
+type AutomationActionsInvocationMetadata struct {
+	Agent    *AgentReference    `json:"agent,omitempty"`
+	Incident *IncidentReference `json:"incident,omitempty"`
+}
+
+// AutomationActionsInvocationPayload represents payload with an automation
+// action invocation object.
+type AutomationActionsInvocationPayload struct {
+	Invocation *AutomationActionsInvocation `json:"invocation,omitempty"`
+}
+
+type createAutomationActionsInvocationPayload struct {
+	Invocation *createAutomationActionsInvocation `json:"invocation"`
+}
+
+type createAutomationActionsInvocation struct {
+	Metadata *createAutomationActionsInvocationMetadata `json:"metadata"`
+}
+
+type createAutomationActionsInvocationMetadata struct {
+	IncidentID string `json:"incident_id"`
+}
+
+// ListAutomationActionsInvocationsOptions represents options when listing
+// automation action invocations.
+type ListAutomationActionsInvocationsOptions struct {
+	InvocationState    AutomationActionsInvocationState `url:"invocation_state,omitempty"`
+	NotInvocationState AutomationActionsInvocationState `url:"not_invocation_state,omitempty"`
+	IncidentID         string                           `url:"incident_id,omitempty"`
+	ActionID           string                           `url:"action_id,omitempty"`
+}
+
+// ListAutomationActionsInvocationsResponse represents a list response of
+// automation action invocations.
+type ListAutomationActionsInvocationsResponse struct {
+	Invocations []*AutomationActionsInvocation `json:"invocations,omitempty"`
+}
+
+var automationActionsInvocationBaseUrl = "/automation_actions/invocations"
+
+// CreateInvocation invokes an action on an incident.
+func (s *AutomationActionsActionService) CreateInvocation(actionID, incidentID string) (*AutomationActionsInvocation, *Response, error) {
+	return s.CreateInvocationContext(context.Background(), actionID, incidentID)
+}
+
+// CreateInvocationContext invokes an action on an incident.
+func (s *AutomationActionsActionService) CreateInvocationContext(ctx context.Context, actionID, incidentID string) (*AutomationActionsInvocation, *Response, error) {
+	u := fmt.Sprintf("%s/%s/invocations", automationActionsActionBaseUrl, actionID)
+	v := new(AutomationActionsInvocationPayload)
+	p := &createAutomationActionsInvocationPayload{
+		Invocation: &createAutomationActionsInvocation{
+			Metadata: &createAutomationActionsInvocationMetadata{IncidentID: incidentID},
+		},
+	}
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Invocation, resp, nil
+}
+
+// GetInvocation retrieves an invocation.
+func (s *AutomationActionsActionService) GetInvocation(id string) (*AutomationActionsInvocation, *Response, error) {
+	return s.GetInvocationContext(context.Background(), id)
+}
+
+// GetInvocationContext retrieves an invocation.
+func (s *AutomationActionsActionService) GetInvocationContext(ctx context.Context, id string) (*AutomationActionsInvocation, *Response, error) {
+	u := fmt.Sprintf("%s/%s", automationActionsInvocationBaseUrl, id)
+	v := new(AutomationActionsInvocationPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Invocation, resp, nil
+}
+
+// ListInvocations lists invocations matching the options.
+func (s *AutomationActionsActionService) ListInvocations(o *ListAutomationActionsInvocationsOptions) (*ListAutomationActionsInvocationsResponse, *Response, error) {
+	return s.ListInvocationsContext(context.Background(), o)
+}
+
+// ListInvocationsContext lists invocations matching the options.
+func (s *AutomationActionsActionService) ListInvocationsContext(ctx context.Context, o *ListAutomationActionsInvocationsOptions) (*ListAutomationActionsInvocationsResponse, *Response, error) {
+	u := automationActionsInvocationBaseUrl
+	v := new(ListAutomationActionsInvocationsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// WaitForInvocation polls an invocation every interval until it reaches a
+// terminal state and returns it. Use WaitForInvocationContext to bound the
+// wait.
+func (s *AutomationActionsActionService) WaitForInvocation(id string, interval time.Duration) (*AutomationActionsInvocation, error) {
+	return s.WaitForInvocationContext(context.Background(), id, interval)
+}
+
+// WaitForInvocationContext polls an invocation every interval until it
+// reaches a terminal state and returns it. It returns the context error if
+// the context is done first. The interval must be positive.
+func (s *AutomationActionsActionService) WaitForInvocationContext(ctx context.Context, id string, interval time.Duration) (*AutomationActionsInvocation, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid polling interval %s", interval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		inv, _, err := s.GetInvocationContext(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if inv == nil {
+			return nil, fmt.Errorf("no invocation %s in response", id)
+		}
+		if inv.State.IsTerminal() {
+			return inv, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
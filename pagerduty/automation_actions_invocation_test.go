@@ -0,0 +1,135 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAutomationActionsActionCreateInvocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/automation_actions/actions/01DA2MLYN0J5EFC1LKWXUKDDKT/invocations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"invocation":{"metadata":{"incident_id":"PINC1"}}}`)
+		w.Write([]byte(`{"invocation": {"id": "01DBJLIGED17S1DQKQC2AV8XYZ", "type": "invocation", "action_id": "01DA2MLYN0J5EFC1LKWXUKDDKT", "state": "prepared", "metadata": {"incident": {"id": "PINC1", "type": "incident_reference"}}}}`))
+	})
+
+	resp, _, err := client.AutomationActionsAction.CreateInvocation("01DA2MLYN0J5EFC1LKWXUKDDKT", "PINC1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &AutomationActionsInvocation{
+		ID:       "01DBJLIGED17S1DQKQC2AV8XYZ",
+		Type:     "invocation",
+		ActionID: "01DA2MLYN0J5EFC1LKWXUKDDKT",
+		State:    AutomationActionsInvocationStatePrepared,
+		Metadata: &AutomationActionsInvocationMetadata{
+			Incident: &IncidentReference{ID: "PINC1", Type: "incident_reference"},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestAutomationActionsActionListInvocations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/automation_actions/invocations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "incident_id", "PINC1")
+		testQueryValue(t, r, "not_invocation_state", "completed")
+		w.Write([]byte(`{"invocations": [{"id": "01DBJLIGED17S1DQKQC2AV8XYZ", "state": "running", "timing": [{"state": "running", "creation_timestamp": "2024-01-01T00:00:00Z"}]}]}`))
+	})
+
+	resp, _, err := client.AutomationActionsAction.ListInvocations(&ListAutomationActionsInvocationsOptions{
+		IncidentID:         "PINC1",
+		NotInvocationState: AutomationActionsInvocationStateCompleted,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAutomationActionsInvocationsResponse{
+		Invocations: []*AutomationActionsInvocation{
+			{
+				ID:    "01DBJLIGED17S1DQKQC2AV8XYZ",
+				State: AutomationActionsInvocationStateRunning,
+				Timing: []*AutomationActionsInvocationTiming{
+					{State: AutomationActionsInvocationStateRunning, CreationTimestamp: "2024-01-01T00:00:00Z"},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestAutomationActionsActionWaitForInvocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	states := []string{"queued", "running", "completed"}
+	calls := 0
+	mux.HandleFunc("/automation_actions/invocations/INV1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		state := states[len(states)-1]
+		if calls < len(states) {
+			state = states[calls]
+		}
+		calls++
+		w.Write([]byte(`{"invocation": {"id": "INV1", "state": "` + state + `"}}`))
+	})
+
+	resp, err := client.AutomationActionsAction.WaitForInvocation("INV1", time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.State != AutomationActionsInvocationStateCompleted {
+		t.Errorf("returned state %q; want %q", resp.State, AutomationActionsInvocationStateCompleted)
+	}
+	if calls != len(states) {
+		t.Errorf("polled %d times; want %d", calls, len(states))
+	}
+}
+
+func TestAutomationActionsActionWaitForInvocationContextDone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/automation_actions/invocations/INV1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"invocation": {"id": "INV1", "state": "running"}}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.AutomationActionsAction.WaitForInvocationContext(ctx, "INV1", time.Millisecond); err == nil {
+		t.Error("expected an error once the context is done")
+	}
+}
+
+func TestAutomationActionsActionWaitForInvocationErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/automation_actions/invocations/INV1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	if _, err := client.AutomationActionsAction.WaitForInvocation("INV1", 0); err == nil {
+		t.Error("expected an error for a zero interval")
+	}
+	if _, err := client.AutomationActionsAction.WaitForInvocation("INV1", time.Millisecond); err == nil {
+		t.Error("expected an error for a response without an invocation")
+	}
+}
@@ -1,6 +1,9 @@
 package pagerduty
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // AutomationActionsRunner handles the communication with schedule
 // related methods of the PagerDuty API.
@@ -33,8 +36,98 @@ type AutomationActionsRunnerTeamAssociationPayload struct {
 	Team *TeamReference `json:"team,omitempty"`
 }
 
+// ListAutomationActionsRunnersOptions represents options when listing
+// automation actions runners.
+type ListAutomationActionsRunnersOptions struct {
+	Limit    int      `url:"limit,omitempty"`
+	Cursor   string   `url:"cursor,omitempty"`
+	Name     string   `url:"name,omitempty"`
+	Includes []string `url:"include,brackets,omitempty"`
+}
+
+// ListAutomationActionsRunnersResponse represents a list response of
+// automation actions runners.
+type ListAutomationActionsRunnersResponse struct {
+	Runners    []*AutomationActionsRunner   `json:"runners,omitempty"`
+	Privileges *AutomationActionsPrivileges `json:"privileges,omitempty"`
+	NextCursor string                       `json:"next_cursor,omitempty"`
+	Limit      int                          `json:"limit,omitempty"`
+}
+
 var automationActionsRunnerBaseUrl = "/automation_actions/runners"
 
+type listAutomationActionsRunnerOptionsGen struct {
+	options *ListAutomationActionsRunnersOptions
+}
+
+func (o *listAutomationActionsRunnerOptionsGen) currentCursor() string {
+	return o.options.Cursor
+}
+
+func (o *listAutomationActionsRunnerOptionsGen) changeCursor(s string) {
+	o.options.Cursor = s
+}
+
+func (o *listAutomationActionsRunnerOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// List lists existing runners. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of runners will be returned.
+func (s *AutomationActionsRunnerService) List(o *ListAutomationActionsRunnersOptions) (*ListAutomationActionsRunnersResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing runners. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of runners will be returned.
+func (s *AutomationActionsRunnerService) ListContext(ctx context.Context, o *ListAutomationActionsRunnersOptions) (*ListAutomationActionsRunnersResponse, *Response, error) {
+	u := automationActionsRunnerBaseUrl
+	v := new(ListAutomationActionsRunnersResponse)
+
+	if o == nil {
+		o = &ListAutomationActionsRunnersOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	items := make([]*AutomationActionsRunner, 0)
+
+	// Create a handler closure capable of parsing data from the runners
+	// endpoint and appending resultant runners to the return slice.
+	responseHandler := func(response *Response) (CursorListResp, *Response, error) {
+		var result ListAutomationActionsRunnersResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return CursorListResp{}, response, err
+		}
+
+		items = append(items, result.Runners...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return CursorListResp{
+			Limit:      result.Limit,
+			NextCursor: result.NextCursor,
+		}, response, nil
+	}
+	err := s.client.newRequestCursorPagedGetQueryDoContext(ctx, u, responseHandler, &listAutomationActionsRunnerOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Runners = items
+
+	return v, nil, nil
+}
+
 // Create creates a new runner
 func (s *AutomationActionsRunnerService) Create(runner *AutomationActionsRunner) (*AutomationActionsRunner, *Response, error) {
 	u := automationActionsRunnerBaseUrl
@@ -256,3 +256,37 @@ func TestAutomationActionsRunnerTeamAssociationGet(t *testing.T) {
 		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
 	}
 }
+
+func TestAutomationActionsRunnerList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/automation_actions/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "limit", "10")
+		testQueryValue(t, r, "name", "prod")
+		w.Write([]byte(`{"runners": [{"id": "01DA2MLYN0J5EFC1LKWXUKDDKT", "name": "prod runner", "type": "runner", "runner_type": "sidecar", "creation_time": "2022-10-21T19:42:52.127369Z"}], "limit": 10}`))
+	})
+
+	resp, _, err := client.AutomationActionsRunner.List(&ListAutomationActionsRunnersOptions{Limit: 10, Name: "prod"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListAutomationActionsRunnersResponse{
+		Runners: []*AutomationActionsRunner{
+			{
+				ID:           "01DA2MLYN0J5EFC1LKWXUKDDKT",
+				Name:         "prod runner",
+				Type:         "runner",
+				RunnerType:   "sidecar",
+				CreationTime: "2022-10-21T19:42:52.127369Z",
+			},
+		},
+		Limit: 10,
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
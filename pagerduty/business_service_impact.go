@@ -0,0 +1,191 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// BusinessServiceImpactStatus is the impact status of a business service.
+type BusinessServiceImpactStatus string
+
+const (
+	BusinessServiceImpactStatusImpacted    BusinessServiceImpactStatus = "impacted"
+	BusinessServiceImpactStatusNotImpacted BusinessServiceImpactStatus = "not_impacted"
+)
+
+// BusinessServiceImpact represents the current impact status of a business
+// service.
+type BusinessServiceImpact struct {
+	ID               string                                 `json:"id,omitempty"`
+	Name             string                                 `json:"name,omitempty"`
+	Type             string                                 `json:"type,omitempty"`
+	Status           BusinessServiceImpactStatus            `json:"status,omitempty"`
+	AdditionalFields *BusinessServiceImpactAdditionalFields `json:"additional_fields,omitempty"`
+}
+
+// BusinessServiceImpactAdditionalFields represents additional details of a
+// business service impact.
+type BusinessServiceImpactAdditionalFields struct {
+	HighestImpactingPriority *PriorityReference `json:"highest_impacting_priority,omitempty"`
+}
+
+// BusinessServiceImpactor represents an incident or business service
+// impacting a business service.
+type BusinessServiceImpactor struct {
+	ID       string             `json:"id,omitempty"`
+	Type     string             `json:"type,omitempty"`
+	Priority *PriorityReference `json:"priority,omitempty"`
+}
+
+// ListBusinessServiceImpactsOptions represents options when listing business
+// service impacts.
+type ListBusinessServiceImpactsOptions struct {
+	IDs              []string `url:"ids,omitempty,comma"`
+	AdditionalFields []string `url:"additional_fields,omitempty,brackets"`
+}
+
+// ListBusinessServiceImpactsResponse represents a list response of business
+// service impacts.
+type ListBusinessServiceImpactsResponse struct {
+	Services []*BusinessServiceImpact `json:"services,omitempty"`
+	Limit    int                      `json:"limit,omitempty"`
+	More     bool                     `json:"more,omitempty"`
+}
+
+// ListBusinessServiceImpactorsOptions represents options when listing the
+// impactors of business services.
+type ListBusinessServiceImpactorsOptions struct {
+	IDs []string `url:"ids,omitempty,comma"`
+}
+
+// ListBusinessServiceImpactorsResponse represents a list response of business
+// service impactors.
+type ListBusinessServiceImpactorsResponse struct {
+	Impactors []*BusinessServiceImpactor `json:"impactors,omitempty"`
+	Limit     int                        `json:"limit,omitempty"`
+	More      bool                       `json:"more,omitempty"`
+}
+
+// BusinessServicePriorityThresholdPayload represents payload with the global
+// business service priority threshold, the priority an incident must reach
+// to impact business services.
+type BusinessServicePriorityThresholdPayload struct {
+	GlobalThreshold *PriorityReference `json:"global_threshold,omitempty"`
+}
+
+// businessImpactEarlyAccessHeader opts requests into the business service
+// impact endpoints.
+var businessImpactEarlyAccessHeader = RequestOptions{
+	Type:  "header",
+	Label: "X-EARLY-ACCESS",
+	Value: "business-impact-early-access",
+}
+
+// ListImpacts lists business services sorted by their impact status.
+func (s *BusinessServiceService) ListImpacts(o *ListBusinessServiceImpactsOptions) (*ListBusinessServiceImpactsResponse, *Response, error) {
+	return s.ListImpactsContext(context.Background(), o)
+}
+
+// ListImpactsContext lists business services sorted by their impact status.
+func (s *BusinessServiceService) ListImpactsContext(ctx context.Context, o *ListBusinessServiceImpactsOptions) (*ListBusinessServiceImpactsResponse, *Response, error) {
+	return s.listImpacts(ctx, "/business_services/impacts", o)
+}
+
+// ListSupportingServiceImpacts lists the business services supporting a
+// business service sorted by their impact status.
+func (s *BusinessServiceService) ListSupportingServiceImpacts(id string, o *ListBusinessServiceImpactsOptions) (*ListBusinessServiceImpactsResponse, *Response, error) {
+	return s.ListSupportingServiceImpactsContext(context.Background(), id, o)
+}
+
+// ListSupportingServiceImpactsContext lists the business services supporting
+// a business service sorted by their impact status.
+func (s *BusinessServiceService) ListSupportingServiceImpactsContext(ctx context.Context, id string, o *ListBusinessServiceImpactsOptions) (*ListBusinessServiceImpactsResponse, *Response, error) {
+	return s.listImpacts(ctx, fmt.Sprintf("/business_services/%s/supporting_services/impacts", id), o)
+}
+
+func (s *BusinessServiceService) listImpacts(ctx context.Context, u string, o *ListBusinessServiceImpactsOptions) (*ListBusinessServiceImpactsResponse, *Response, error) {
+	v := new(ListBusinessServiceImpactsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, businessImpactEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// ListImpactors lists the incidents and business services impacting
+// business services. Impactors of specific business services are listed by
+// passing their IDs in the options.
+func (s *BusinessServiceService) ListImpactors(o *ListBusinessServiceImpactorsOptions) (*ListBusinessServiceImpactorsResponse, *Response, error) {
+	return s.ListImpactorsContext(context.Background(), o)
+}
+
+// ListImpactorsContext lists the incidents and business services impacting
+// business services. Impactors of specific business services are listed by
+// passing their IDs in the options.
+func (s *BusinessServiceService) ListImpactorsContext(ctx context.Context, o *ListBusinessServiceImpactorsOptions) (*ListBusinessServiceImpactorsResponse, *Response, error) {
+	u := "/business_services/impactors"
+	v := new(ListBusinessServiceImpactorsResponse)
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "GET", u, o, nil, v, businessImpactEarlyAccessHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetPriorityThreshold gets the global priority threshold for business
+// service impact.
+func (s *BusinessServiceService) GetPriorityThreshold() (*PriorityReference, *Response, error) {
+	return s.GetPriorityThresholdContext(context.Background())
+}
+
+// GetPriorityThresholdContext gets the global priority threshold for
+// business service impact.
+func (s *BusinessServiceService) GetPriorityThresholdContext(ctx context.Context) (*PriorityReference, *Response, error) {
+	u := "/business_services/priority_thresholds"
+	v := new(BusinessServicePriorityThresholdPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.GlobalThreshold, resp, nil
+}
+
+// UpdatePriorityThreshold sets the global priority threshold for business
+// service impact.
+func (s *BusinessServiceService) UpdatePriorityThreshold(threshold *PriorityReference) (*PriorityReference, *Response, error) {
+	return s.UpdatePriorityThresholdContext(context.Background(), threshold)
+}
+
+// UpdatePriorityThresholdContext sets the global priority threshold for
+// business service impact.
+func (s *BusinessServiceService) UpdatePriorityThresholdContext(ctx context.Context, threshold *PriorityReference) (*PriorityReference, *Response, error) {
+	u := "/business_services/priority_thresholds"
+	v := new(BusinessServicePriorityThresholdPayload)
+	p := &BusinessServicePriorityThresholdPayload{GlobalThreshold: threshold}
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.GlobalThreshold, resp, nil
+}
+
+// ClearPriorityThreshold clears the global priority threshold so that
+// incidents of any priority impact business services.
+func (s *BusinessServiceService) ClearPriorityThreshold() (*Response, error) {
+	return s.ClearPriorityThresholdContext(context.Background())
+}
+
+// ClearPriorityThresholdContext clears the global priority threshold so that
+// incidents of any priority impact business services.
+func (s *BusinessServiceService) ClearPriorityThresholdContext(ctx context.Context) (*Response, error) {
+	u := "/business_services/priority_thresholds"
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
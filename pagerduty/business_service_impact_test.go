@@ -0,0 +1,145 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestBusinessServiceListImpacts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/business_services/impacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-EARLY-ACCESS", "business-impact-early-access")
+		testQueryValue(t, r, "ids", "PBS1,PBS2")
+		w.Write([]byte(`{"services": [
+			{"id": "PBS1", "name": "Checkout", "type": "business_service", "status": "impacted", "additional_fields": {"highest_impacting_priority": {"id": "PPRIO1", "order": 1}}},
+			{"id": "PBS2", "name": "Search", "type": "business_service", "status": "not_impacted"}
+		], "limit": 100, "more": false}`))
+	})
+
+	resp, _, err := client.BusinessServices.ListImpacts(&ListBusinessServiceImpactsOptions{
+		IDs:              []string{"PBS1", "PBS2"},
+		AdditionalFields: []string{"services.highest_impacting_priority"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListBusinessServiceImpactsResponse{
+		Services: []*BusinessServiceImpact{
+			{
+				ID:     "PBS1",
+				Name:   "Checkout",
+				Type:   "business_service",
+				Status: BusinessServiceImpactStatusImpacted,
+				AdditionalFields: &BusinessServiceImpactAdditionalFields{
+					HighestImpactingPriority: &PriorityReference{ID: "PPRIO1", Order: 1},
+				},
+			},
+			{
+				ID:     "PBS2",
+				Name:   "Search",
+				Type:   "business_service",
+				Status: BusinessServiceImpactStatusNotImpacted,
+			},
+		},
+		Limit: 100,
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestBusinessServiceListSupportingServiceImpacts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/business_services/PBS1/supporting_services/impacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-EARLY-ACCESS", "business-impact-early-access")
+		w.Write([]byte(`{"services": [{"id": "PBS3", "status": "impacted"}]}`))
+	})
+
+	resp, _, err := client.BusinessServices.ListSupportingServiceImpacts("PBS1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListBusinessServiceImpactsResponse{
+		Services: []*BusinessServiceImpact{{ID: "PBS3", Status: BusinessServiceImpactStatusImpacted}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestBusinessServiceListImpactors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/business_services/impactors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "X-EARLY-ACCESS", "business-impact-early-access")
+		testQueryValue(t, r, "ids", "PBS1")
+		w.Write([]byte(`{"impactors": [{"id": "PINC1", "type": "incident", "priority": {"id": "PPRIO1", "order": 1}}]}`))
+	})
+
+	resp, _, err := client.BusinessServices.ListImpactors(&ListBusinessServiceImpactorsOptions{IDs: []string{"PBS1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListBusinessServiceImpactorsResponse{
+		Impactors: []*BusinessServiceImpactor{
+			{ID: "PINC1", Type: "incident", Priority: &PriorityReference{ID: "PPRIO1", Order: 1}},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestBusinessServicePriorityThreshold(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/business_services/priority_thresholds", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"global_threshold": {"id": "PPRIO1", "order": 1}}`))
+		case "PUT":
+			testBody(t, r, `{"global_threshold":{"id":"PPRIO2","order":2}}`)
+			w.Write([]byte(`{"global_threshold": {"id": "PPRIO2", "order": 2}}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	threshold, _, err := client.BusinessServices.GetPriorityThreshold()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&PriorityReference{ID: "PPRIO1", Order: 1}); !reflect.DeepEqual(threshold, want) {
+		t.Errorf("returned %#v; want %#v", threshold, want)
+	}
+
+	threshold, _, err = client.BusinessServices.UpdatePriorityThreshold(&PriorityReference{ID: "PPRIO2", Order: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&PriorityReference{ID: "PPRIO2", Order: 2}); !reflect.DeepEqual(threshold, want) {
+		t.Errorf("returned %#v; want %#v", threshold, want)
+	}
+
+	if _, err := client.BusinessServices.ClearPriorityThreshold(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -13,6 +13,10 @@ var (
 	// ErrAuthFailure is returned by NewClient if a user
 	// passed an invalid token and failed validation against the PagerDuty API.
 	ErrAuthFailure = errors.New("failed to authenticate using the provided token")
+
+	// ErrNoFrom is returned by calls that must be attributed to a user when
+	// neither the call nor Config.DefaultFrom provides a requester email.
+	ErrNoFrom = errors.New("a requester email is required for the From header: set Config.DefaultFrom or provide one with the call")
 )
 
 type errorResponse struct {
@@ -0,0 +1,290 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// Cache variable configuration types.
+const (
+	CacheVariableConfigurationRecentValue       = "recent_value"
+	CacheVariableConfigurationTriggerEventCount = "trigger_event_count"
+	CacheVariableConfigurationExternalData      = "external_data"
+)
+
+// TimedOrchestrationEvent is an event together with when it was received.
+// When At is zero the RFC 3339 timestamp of the event is used instead.
+type TimedOrchestrationEvent struct {
+	At    time.Time
+	Event *OrchestrationEvent
+}
+
+func (e *TimedOrchestrationEvent) time() (time.Time, error) {
+	if !e.At.IsZero() {
+		return e.At, nil
+	}
+	if e.Event == nil || e.Event.Timestamp == "" {
+		return time.Time{}, fmt.Errorf("event has no time")
+	}
+	t, err := time.Parse(time.RFC3339, e.Event.Timestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid event timestamp %q: %v", e.Event.Timestamp, err)
+	}
+	return t, nil
+}
+
+// CacheVariableSnapshot holds the values of the cache variables right after
+// an event was taken into account, as rules evaluating that event see them.
+type CacheVariableSnapshot struct {
+	At     time.Time
+	Event  *OrchestrationEvent
+	Values map[string]interface{}
+}
+
+// CacheVariableSimulator replays events through cache variables to tell
+// their values over time:
+//
+//   - recent_value variables hold the value extracted with Regex from the
+//     Source field of the last matching event, until TTLSeconds pass if set.
+//   - trigger_event_count variables count the matching trigger events of
+//     the last TTLSeconds.
+//   - external_data variables hold what SetExternalData gave them until
+//     TTLSeconds pass if set.
+//
+// Disabled variables never have a value. Events must be replayed in time
+// order.
+type CacheVariableSimulator struct {
+	variables  []*EventOrchestrationCacheVariable
+	conditions map[string][]*PCLExpression
+	regexes    map[string]*regexp.Regexp
+
+	values   map[string]cacheVariableValue
+	triggers map[string][]time.Time
+	last     time.Time
+}
+
+type cacheVariableValue struct {
+	value interface{}
+	at    time.Time
+}
+
+// NewCacheVariableSimulator returns a simulator for the given cache
+// variables, with no events seen yet. It fails if a condition or regex is
+// invalid or a variable has an unknown type.
+func NewCacheVariableSimulator(variables []*EventOrchestrationCacheVariable) (*CacheVariableSimulator, error) {
+	s := &CacheVariableSimulator{
+		variables:  variables,
+		conditions: make(map[string][]*PCLExpression),
+		regexes:    make(map[string]*regexp.Regexp),
+		values:     make(map[string]cacheVariableValue),
+		triggers:   make(map[string][]time.Time),
+	}
+
+	for _, v := range variables {
+		if v.Configuration == nil {
+			return nil, fmt.Errorf("cache variable %s has no configuration", v.Name)
+		}
+		for _, c := range v.Conditions {
+			expr, err := ParsePCL(c.Expression)
+			if err != nil {
+				return nil, fmt.Errorf("cache variable %s: %v", v.Name, err)
+			}
+			s.conditions[v.Name] = append(s.conditions[v.Name], expr)
+		}
+
+		switch v.Configuration.Type {
+		case CacheVariableConfigurationRecentValue:
+			re, err := regexp.Compile(v.Configuration.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("cache variable %s: invalid regex %q: %v", v.Name, v.Configuration.Regex, err)
+			}
+			if _, err := splitOrchestrationPath(v.Configuration.Source); err != nil {
+				return nil, fmt.Errorf("cache variable %s: %v", v.Name, err)
+			}
+			s.regexes[v.Name] = re
+		case CacheVariableConfigurationTriggerEventCount:
+			if v.Configuration.TTLSeconds <= 0 {
+				return nil, fmt.Errorf("cache variable %s: trigger_event_count requires a positive ttl_seconds", v.Name)
+			}
+		case CacheVariableConfigurationExternalData:
+		default:
+			return nil, fmt.Errorf("cache variable %s has unknown type %q", v.Name, v.Configuration.Type)
+		}
+	}
+
+	return s, nil
+}
+
+// SetExternalData sets the value of an external_data cache variable as if
+// it was sent to its data endpoint at the given time.
+func (s *CacheVariableSimulator) SetExternalData(name string, value interface{}, at time.Time) error {
+	for _, v := range s.variables {
+		if v.Name != name {
+			continue
+		}
+		if v.Configuration.Type != CacheVariableConfigurationExternalData {
+			return fmt.Errorf("cache variable %s is not external_data", name)
+		}
+		s.values[name] = cacheVariableValue{value: value, at: at}
+		return nil
+	}
+	return fmt.Errorf("no cache variable named %s", name)
+}
+
+// Process takes an event received at the given time into account and
+// returns the values of the cache variables at that time.
+func (s *CacheVariableSimulator) Process(at time.Time, event *OrchestrationEvent) (map[string]interface{}, error) {
+	if event == nil {
+		return nil, fmt.Errorf("no event at %s", at.Format(time.RFC3339))
+	}
+	if at.Before(s.last) {
+		return nil, fmt.Errorf("event at %s is older than the previous one at %s", at.Format(time.RFC3339), s.last.Format(time.RFC3339))
+	}
+	s.last = at
+
+	ctx := &PCLContext{Event: event, Now: at}
+	for _, v := range s.variables {
+		if v.Disabled {
+			continue
+		}
+		ok, err := s.matches(v, ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		switch v.Configuration.Type {
+		case CacheVariableConfigurationRecentValue:
+			path, _ := splitOrchestrationPath(v.Configuration.Source)
+			field, ok := ctx.lookup(path)
+			if !ok {
+				continue
+			}
+			m := s.regexes[v.Name].FindStringSubmatch(pclString(field))
+			if m == nil {
+				continue
+			}
+			value := m[0]
+			if len(m) > 1 {
+				value = m[1]
+			}
+			s.values[v.Name] = cacheVariableValue{value: value, at: at}
+		case CacheVariableConfigurationTriggerEventCount:
+			if event.EventAction == "" || event.EventAction == "trigger" {
+				s.triggers[v.Name] = append(s.triggers[v.Name], at)
+			}
+		}
+	}
+
+	// Events are processed in order, so triggers that fell out of their
+	// window now will not count again.
+	for _, v := range s.variables {
+		if v.Configuration.Type != CacheVariableConfigurationTriggerEventCount {
+			continue
+		}
+		times := s.triggers[v.Name]
+		i := countExpiredTriggers(times, at, v.Configuration.TTLSeconds)
+		s.triggers[v.Name] = times[i:]
+	}
+
+	return s.Values(at), nil
+}
+
+func (s *CacheVariableSimulator) matches(v *EventOrchestrationCacheVariable, ctx *PCLContext) (bool, error) {
+	exprs := s.conditions[v.Name]
+	if len(exprs) == 0 {
+		return true, nil
+	}
+	for _, e := range exprs {
+		ok, err := e.Evaluate(ctx)
+		if err != nil {
+			return false, fmt.Errorf("cache variable %s: %v", v.Name, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Values returns the values of the cache variables at the given time,
+// which must not be before the last processed event. Variables without a
+// value are left out, except trigger_event_count ones which are 0. It does
+// not change the state of the simulator.
+func (s *CacheVariableSimulator) Values(at time.Time) map[string]interface{} {
+	values := make(map[string]interface{})
+	for _, v := range s.variables {
+		if v.Disabled {
+			continue
+		}
+		ttl := time.Duration(v.Configuration.TTLSeconds) * time.Second
+
+		if v.Configuration.Type == CacheVariableConfigurationTriggerEventCount {
+			times := s.triggers[v.Name]
+			values[v.Name] = len(times) - countExpiredTriggers(times, at, v.Configuration.TTLSeconds)
+			continue
+		}
+
+		cv, ok := s.values[v.Name]
+		if !ok || (ttl > 0 && !cv.at.Add(ttl).After(at)) {
+			continue
+		}
+		values[v.Name] = cv.value
+	}
+	return values
+}
+
+// countExpiredTriggers returns how many of the ordered trigger times are out
+// of the window of ttlSeconds ending at the given time.
+func countExpiredTriggers(times []time.Time, at time.Time, ttlSeconds int) int {
+	start := at.Add(-time.Duration(ttlSeconds) * time.Second)
+	i := 0
+	for i < len(times) && !times[i].After(start) {
+		i++
+	}
+	return i
+}
+
+// Replay processes events in order and returns the values of the cache
+// variables after each of them.
+func (s *CacheVariableSimulator) Replay(events []*TimedOrchestrationEvent) ([]*CacheVariableSnapshot, error) {
+	snapshots := make([]*CacheVariableSnapshot, 0, len(events))
+	for i, e := range events {
+		at, err := e.time()
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %v", i, err)
+		}
+		values, err := s.Process(at, e.Event)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %v", i, err)
+		}
+		snapshots = append(snapshots, &CacheVariableSnapshot{At: at, Event: e.Event, Values: values})
+	}
+	return snapshots, nil
+}
+
+// SimulateStream replays events through cache variables and simulates each
+// of them with the cache variable values and time it was received at. The
+// CacheVariables and Now fields of the simulator are left unchanged.
+func (s *OrchestrationSimulator) SimulateStream(cache *CacheVariableSimulator, events []*TimedOrchestrationEvent) ([]*OrchestrationSimulation, error) {
+	snapshots, err := cache.Replay(events)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*OrchestrationSimulation, 0, len(snapshots))
+	for i, snap := range snapshots {
+		sim := *s
+		sim.CacheVariables = snap.Values
+		sim.Now = snap.At
+		res, err := sim.Simulate(snap.Event)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %v", i, err)
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
@@ -0,0 +1,180 @@
+package pagerduty
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testCacheVariables() []*EventOrchestrationCacheVariable {
+	return []*EventOrchestrationCacheVariable{
+		{
+			Name:       "recent_host",
+			Conditions: []*EventOrchestrationCacheVariableCondition{{Expression: "event.summary matches part 'down'"}},
+			Configuration: &EventOrchestrationCacheVariableConfiguration{
+				Type:   CacheVariableConfigurationRecentValue,
+				Source: "event.source",
+				Regex:  `^([a-z0-9-]+)\.`,
+			},
+		},
+		{
+			Name: "flaps",
+			Configuration: &EventOrchestrationCacheVariableConfiguration{
+				Type:       CacheVariableConfigurationTriggerEventCount,
+				TTLSeconds: 60,
+			},
+		},
+		{
+			Name:     "disabled",
+			Disabled: true,
+			Configuration: &EventOrchestrationCacheVariableConfiguration{
+				Type:       CacheVariableConfigurationTriggerEventCount,
+				TTLSeconds: 60,
+			},
+		},
+		{
+			Name: "maintenance",
+			Configuration: &EventOrchestrationCacheVariableConfiguration{
+				Type:       CacheVariableConfigurationExternalData,
+				DataType:   "boolean",
+				TTLSeconds: 90,
+			},
+		},
+	}
+}
+
+func TestCacheVariableSimulatorReplay(t *testing.T) {
+	s, err := NewCacheVariableSimulator(testCacheVariables())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	if err := s.SetExternalData("maintenance", true, start); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots, err := s.Replay([]*TimedOrchestrationEvent{
+		{At: start, Event: &OrchestrationEvent{Summary: "web-1 down", Source: "web-1.prod"}},
+		{At: start.Add(30 * time.Second), Event: &OrchestrationEvent{Summary: "web-1 up", Source: "web-1.prod", EventAction: "resolve"}},
+		{At: start.Add(45 * time.Second), Event: &OrchestrationEvent{Summary: "db-2 down", Source: "db-2.prod"}},
+		{Event: &OrchestrationEvent{Summary: "cpu high", Source: "api-3.prod", Timestamp: "2024-01-01T12:01:40Z"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []map[string]interface{}{
+		{"recent_host": "web-1", "flaps": 1, "maintenance": true},
+		{"recent_host": "web-1", "flaps": 1, "maintenance": true},
+		{"recent_host": "db-2", "flaps": 2, "maintenance": true},
+		{"recent_host": "db-2", "flaps": 2},
+	}
+	for i, snap := range snapshots {
+		if !reflect.DeepEqual(snap.Values, want[i]) {
+			t.Errorf("values after event %d: %v; want %v", i, snap.Values, want[i])
+		}
+	}
+	if !snapshots[3].At.Equal(start.Add(100 * time.Second)) {
+		t.Errorf("event time %s was not read from its timestamp", snapshots[3].At)
+	}
+
+	if got := s.Values(start.Add(200 * time.Second)); !reflect.DeepEqual(got, map[string]interface{}{"recent_host": "db-2", "flaps": 0}) {
+		t.Errorf("values after the window %v", got)
+	}
+}
+
+func TestCacheVariableSimulatorValuesReadOnly(t *testing.T) {
+	s, err := NewCacheVariableSimulator(testCacheVariables())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	if _, err := s.Process(start, &OrchestrationEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Values(start.Add(time.Hour))["flaps"]; got != 0 {
+		t.Errorf("flaps an hour later %v; want 0", got)
+	}
+
+	values, err := s.Process(start.Add(30*time.Second), &OrchestrationEvent{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := values["flaps"]; got != 2 {
+		t.Errorf("flaps %v; want 2", got)
+	}
+}
+
+func TestCacheVariableSimulatorErrors(t *testing.T) {
+	invalid := [][]*EventOrchestrationCacheVariable{
+		{{Name: "a", Configuration: &EventOrchestrationCacheVariableConfiguration{Type: "recent_value", Source: "event.summary", Regex: "("}}},
+		{{Name: "a", Configuration: &EventOrchestrationCacheVariableConfiguration{Type: "trigger_event_count"}}},
+		{{Name: "a", Configuration: &EventOrchestrationCacheVariableConfiguration{Type: "unknown"}}},
+		{{Name: "a", Conditions: []*EventOrchestrationCacheVariableCondition{{Expression: "event.summary"}}, Configuration: &EventOrchestrationCacheVariableConfiguration{Type: "external_data"}}},
+		{{Name: "a"}},
+	}
+	for _, vars := range invalid {
+		if _, err := NewCacheVariableSimulator(vars); err == nil {
+			t.Errorf("expected an error for %+v", vars[0])
+		}
+	}
+
+	s, err := NewCacheVariableSimulator(testCacheVariables())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	if _, err := s.Process(now, &OrchestrationEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Process(now.Add(-time.Second), &OrchestrationEvent{}); err == nil {
+		t.Error("expected an error for an out of order event")
+	}
+	if _, err := s.Replay([]*TimedOrchestrationEvent{{At: now}}); err == nil {
+		t.Error("expected an error for a missing event")
+	}
+	if err := s.SetExternalData("flaps", 1, now); err == nil {
+		t.Error("expected an error setting a trigger_event_count variable")
+	}
+}
+
+func TestOrchestrationSimulatorSimulateStream(t *testing.T) {
+	cache, err := NewCacheVariableSimulator(testCacheVariables())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim := &OrchestrationSimulator{
+		Global: &EventOrchestrationPath{
+			Sets: []*EventOrchestrationPathSet{{ID: "start", Rules: []*EventOrchestrationPathRule{
+				{
+					ID:         "flapping",
+					Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "cache_var.flaps matches '3'"}},
+					Actions:    &EventOrchestrationPathRuleActions{Suppress: true},
+				},
+			}}},
+		},
+	}
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var events []*TimedOrchestrationEvent
+	for i := 0; i < 4; i++ {
+		events = append(events, &TimedOrchestrationEvent{At: start.Add(time.Duration(i) * 10 * time.Second), Event: &OrchestrationEvent{Summary: "flap"}})
+	}
+
+	results, err := sim.SimulateStream(cache, events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var suppressed []bool
+	for _, r := range results {
+		suppressed = append(suppressed, r.Suppressed)
+	}
+	if want := []bool{false, false, true, false}; !reflect.DeepEqual(suppressed, want) {
+		t.Errorf("suppressed %v; want %v", suppressed, want)
+	}
+	if sim.CacheVariables != nil || !sim.Now.IsZero() {
+		t.Error("SimulateStream changed the simulator")
+	}
+}
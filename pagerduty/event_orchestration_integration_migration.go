@@ -0,0 +1,144 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// EventOrchestrationIntegrationMigration records a bulk migration of
+// integrations from one orchestration to another.
+type EventOrchestrationIntegrationMigration struct {
+	SourceOrchestrationID      string
+	DestinationOrchestrationID string
+
+	// Moved lists the integrations moved to the destination, in the order
+	// they were moved, as they were in the source.
+	Moved []*EventOrchestrationIntegration
+
+	// RolledBack is set once the moved integrations were migrated back to
+	// the source.
+	RolledBack bool
+}
+
+// MigrateAllFromOrchestrationContext moves integrations from the source
+// orchestration to the destination one. With no IDs every integration of
+// the source is moved, otherwise only the given ones, which must all exist
+// and be distinct.
+//
+// Once moved, the routing keys of the integrations are checked to be listed
+// by the destination and no longer by the source. If a move or this
+// verification fails, the integrations already moved are migrated back and
+// the error is returned along with the record of the migration.
+func (s *EventOrchestrationIntegrationService) MigrateAllFromOrchestrationContext(ctx context.Context, destinationOrchestrationId string, sourceOrchestrationId string, ids []string) (*EventOrchestrationIntegrationMigration, error) {
+	m := &EventOrchestrationIntegrationMigration{
+		SourceOrchestrationID:      sourceOrchestrationId,
+		DestinationOrchestrationID: destinationOrchestrationId,
+	}
+
+	source, _, err := s.ListContext(ctx, sourceOrchestrationId)
+	if err != nil {
+		return m, err
+	}
+
+	selected := source.Integrations
+	if len(ids) > 0 {
+		byID := make(map[string]*EventOrchestrationIntegration)
+		for _, i := range source.Integrations {
+			byID[i.ID] = i
+		}
+		selected = nil
+		seen := make(map[string]bool)
+		for _, id := range ids {
+			if seen[id] {
+				return m, fmt.Errorf("integration %s is listed more than once", id)
+			}
+			seen[id] = true
+			i, ok := byID[id]
+			if !ok {
+				return m, fmt.Errorf("orchestration %s has no integration %s", sourceOrchestrationId, id)
+			}
+			selected = append(selected, i)
+		}
+	}
+
+	for _, i := range selected {
+		if _, _, err := s.MigrateFromOrchestrationContext(ctx, destinationOrchestrationId, sourceOrchestrationId, i.ID); err != nil {
+			return m, s.rollbackAfter(ctx, m, fmt.Errorf("failed to migrate integration %s: %v", i.ID, err))
+		}
+		m.Moved = append(m.Moved, i)
+	}
+
+	if err := s.verifyIntegrationMigration(ctx, m); err != nil {
+		return m, s.rollbackAfter(ctx, m, err)
+	}
+
+	return m, nil
+}
+
+func (s *EventOrchestrationIntegrationService) rollbackAfter(ctx context.Context, m *EventOrchestrationIntegrationMigration, err error) error {
+	if len(m.Moved) == 0 {
+		return err
+	}
+	if rerr := s.RollbackIntegrationMigrationContext(ctx, m); rerr != nil {
+		return fmt.Errorf("%v; rollback failed: %v", err, rerr)
+	}
+	return fmt.Errorf("%v; the migration was rolled back", err)
+}
+
+// RollbackIntegrationMigrationContext migrates the integrations moved by a
+// migration back to their source orchestration, most recently moved first.
+// It keeps going when an integration cannot be moved back and reports all
+// of those that could not.
+func (s *EventOrchestrationIntegrationService) RollbackIntegrationMigrationContext(ctx context.Context, m *EventOrchestrationIntegrationMigration) error {
+	var failed []string
+	for i := len(m.Moved) - 1; i >= 0; i-- {
+		id := m.Moved[i].ID
+		if _, _, err := s.MigrateFromOrchestrationContext(ctx, m.SourceOrchestrationID, m.DestinationOrchestrationID, id); err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%v)", id, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to migrate back integrations %s", strings.Join(failed, ", "))
+	}
+	m.RolledBack = true
+	return nil
+}
+
+func (s *EventOrchestrationIntegrationService) verifyIntegrationMigration(ctx context.Context, m *EventOrchestrationIntegrationMigration) error {
+	destination, _, err := s.ListContext(ctx, m.DestinationOrchestrationID)
+	if err != nil {
+		return err
+	}
+	source, _, err := s.ListContext(ctx, m.SourceOrchestrationID)
+	if err != nil {
+		return err
+	}
+
+	routingKeys := func(integrations []*EventOrchestrationIntegration) map[string]bool {
+		keys := make(map[string]bool)
+		for _, i := range integrations {
+			if i.Parameters != nil {
+				keys[i.Parameters.RoutingKey] = true
+			}
+		}
+		return keys
+	}
+	inDestination := routingKeys(destination.Integrations)
+	inSource := routingKeys(source.Integrations)
+
+	for _, i := range m.Moved {
+		if i.Parameters == nil {
+			continue
+		}
+		key := i.Parameters.RoutingKey
+		if !inDestination[key] {
+			return fmt.Errorf("routing key of integration %s is not listed by orchestration %s", i.ID, m.DestinationOrchestrationID)
+		}
+		if inSource[key] {
+			return fmt.Errorf("routing key of integration %s is still listed by orchestration %s", i.ID, m.SourceOrchestrationID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// integrationMigrationServer keeps the integrations of orchestrations in
+// memory and moves them on migration requests.
+type integrationMigrationServer struct {
+	integrations map[string][]*EventOrchestrationIntegration
+	migrations   []string
+
+	// failOn makes the migration of this integration fail.
+	failOn string
+	// keepInSource makes migrations copy integrations instead of moving them.
+	keepInSource bool
+}
+
+func setupIntegrationMigration(t *testing.T) *integrationMigrationServer {
+	srv := &integrationMigrationServer{
+		integrations: map[string][]*EventOrchestrationIntegration{
+			"SRC": {
+				{ID: "I1", Parameters: &EventOrchestrationIntegrationParameters{RoutingKey: "R1"}},
+				{ID: "I2", Parameters: &EventOrchestrationIntegrationParameters{RoutingKey: "R2"}},
+				{ID: "I3", Parameters: &EventOrchestrationIntegrationParameters{RoutingKey: "R3"}},
+			},
+			"DST": {
+				{ID: "I0", Parameters: &EventOrchestrationIntegrationParameters{RoutingKey: "R0"}},
+			},
+		},
+	}
+
+	for _, id := range []string{"SRC", "DST"} {
+		id := id
+		mux.HandleFunc(fmt.Sprintf("%s/%s/integrations", eventOrchestrationBaseUrl, id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			json.NewEncoder(w).Encode(&ListEventOrchestrationIntegrationsResponse{Integrations: srv.integrations[id]})
+		})
+		mux.HandleFunc(fmt.Sprintf("%s/%s/integrations/migration", eventOrchestrationBaseUrl, id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			v := new(EventOrchestrationIntegrationMigrationPayload)
+			json.NewDecoder(r.Body).Decode(v)
+			srv.migrations = append(srv.migrations, fmt.Sprintf("%s %s->%s", v.IntegrationId, v.SourceId, id))
+
+			if v.IntegrationId == srv.failOn {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error": {"message": "Migration failed"}}`))
+				return
+			}
+
+			var kept []*EventOrchestrationIntegration
+			for _, i := range srv.integrations[v.SourceId] {
+				if i.ID == v.IntegrationId {
+					srv.integrations[id] = append(srv.integrations[id], i)
+					if !srv.keepInSource {
+						continue
+					}
+				}
+				kept = append(kept, i)
+			}
+			srv.integrations[v.SourceId] = kept
+			json.NewEncoder(w).Encode(&ListEventOrchestrationIntegrationsResponse{Integrations: srv.integrations[id]})
+		})
+	}
+
+	return srv
+}
+
+func TestEventOrchestrationIntegrationMigrateAll(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupIntegrationMigration(t)
+
+	m, err := client.EventOrchestrationIntegrations.MigrateAllFromOrchestrationContext(context.Background(), "DST", "SRC", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var moved []string
+	for _, i := range m.Moved {
+		moved = append(moved, i.ID)
+	}
+	if want := []string{"I1", "I2", "I3"}; !reflect.DeepEqual(moved, want) {
+		t.Errorf("moved %v; want %v", moved, want)
+	}
+	if len(srv.integrations["SRC"]) != 0 || len(srv.integrations["DST"]) != 4 || m.RolledBack {
+		t.Errorf("unexpected state %+v", srv.integrations)
+	}
+}
+
+func TestEventOrchestrationIntegrationMigrateSelected(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupIntegrationMigration(t)
+
+	m, err := client.EventOrchestrationIntegrations.MigrateAllFromOrchestrationContext(context.Background(), "DST", "SRC", []string{"I3", "I1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"I3 SRC->DST", "I1 SRC->DST"}; !reflect.DeepEqual(srv.migrations, want) {
+		t.Errorf("migrations %v; want %v", srv.migrations, want)
+	}
+	if len(m.Moved) != 2 || len(srv.integrations["SRC"]) != 1 {
+		t.Errorf("unexpected state %+v", srv.integrations)
+	}
+
+	if _, err := client.EventOrchestrationIntegrations.MigrateAllFromOrchestrationContext(context.Background(), "DST", "SRC", []string{"I2", "I9"}); err == nil {
+		t.Error("expected an error for an unknown integration")
+	}
+	if _, err := client.EventOrchestrationIntegrations.MigrateAllFromOrchestrationContext(context.Background(), "DST", "SRC", []string{"I2", "I2"}); err == nil {
+		t.Error("expected an error for a duplicate integration")
+	}
+	if len(srv.migrations) != 2 {
+		t.Errorf("integrations were moved despite the invalid selections: %v", srv.migrations)
+	}
+}
+
+func TestEventOrchestrationIntegrationMigrateAllRollback(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupIntegrationMigration(t)
+	srv.failOn = "I3"
+
+	m, err := client.EventOrchestrationIntegrations.MigrateAllFromOrchestrationContext(context.Background(), "DST", "SRC", nil)
+	if err == nil || !strings.Contains(err.Error(), "rolled back") {
+		t.Fatalf("expected a rolled back error, got %v", err)
+	}
+	if !m.RolledBack {
+		t.Error("migration not marked as rolled back")
+	}
+
+	want := []string{"I1 SRC->DST", "I2 SRC->DST", "I3 SRC->DST", "I2 DST->SRC", "I1 DST->SRC"}
+	if !reflect.DeepEqual(srv.migrations, want) {
+		t.Errorf("migrations %v; want %v", srv.migrations, want)
+	}
+	if len(srv.integrations["SRC"]) != 3 || len(srv.integrations["DST"]) != 1 {
+		t.Errorf("unexpected state %+v", srv.integrations)
+	}
+}
+
+func TestEventOrchestrationIntegrationMigrateAllVerify(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupIntegrationMigration(t)
+	srv.keepInSource = true
+
+	m, err := client.EventOrchestrationIntegrations.MigrateAllFromOrchestrationContext(context.Background(), "DST", "SRC", []string{"I1"})
+	if err == nil || !strings.Contains(err.Error(), "still listed by orchestration SRC") {
+		t.Fatalf("expected a verification error, got %v", err)
+	}
+	if !m.RolledBack || len(m.Moved) != 1 {
+		t.Errorf("unexpected migration %+v", m)
+	}
+}
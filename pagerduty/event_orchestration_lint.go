@@ -0,0 +1,231 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// OrchestrationLintSeverity is how serious a problem found by the linter
+// is. Errors make the path misbehave or be rejected, warnings are likely
+// mistakes.
+type OrchestrationLintSeverity string
+
+const (
+	OrchestrationLintError   OrchestrationLintSeverity = "error"
+	OrchestrationLintWarning OrchestrationLintSeverity = "warning"
+)
+
+// Checks run by LintEventOrchestrationPath.
+const (
+	OrchestrationLintUnknownSet              = "unknown_set"
+	OrchestrationLintUnreachableRule         = "unreachable_rule"
+	OrchestrationLintInvalidCondition        = "invalid_condition"
+	OrchestrationLintInvalidRegex            = "invalid_regex"
+	OrchestrationLintUndefinedVariable       = "undefined_variable"
+	OrchestrationLintEmptyConditions         = "empty_conditions"
+	OrchestrationLintUnknownAutomationAction = "unknown_automation_action"
+)
+
+// OrchestrationLintIssue is a problem found in a path. SetID and RuleID
+// locate the rule, with RuleIndex its 0-based position in the set; they are
+// empty, and RuleIndex nil, for problems in the catch-all actions.
+type OrchestrationLintIssue struct {
+	Severity  OrchestrationLintSeverity `json:"severity"`
+	Check     string                    `json:"check"`
+	PathType  string                    `json:"path_type,omitempty"`
+	SetID     string                    `json:"set_id,omitempty"`
+	RuleID    string                    `json:"rule_id,omitempty"`
+	RuleIndex *int                      `json:"rule_index,omitempty"`
+	CatchAll  bool                      `json:"catch_all,omitempty"`
+	Message   string                    `json:"message"`
+}
+
+func (i *OrchestrationLintIssue) String() string {
+	where := i.PathType
+	switch {
+	case i.CatchAll:
+		where += " catch_all"
+	case i.RuleID != "":
+		where += fmt.Sprintf(" set %s rule %s", i.SetID, i.RuleID)
+	case i.RuleIndex != nil:
+		where += fmt.Sprintf(" set %s rule #%d", i.SetID, *i.RuleIndex)
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", i.Severity, where, i.Message, i.Check)
+}
+
+// OrchestrationLintOptions configures LintEventOrchestrationPath.
+type OrchestrationLintOptions struct {
+	// AutomationActionIDs holds the IDs of the existing automation actions.
+	// PagerDuty automation actions are only checked when it is not nil.
+	AutomationActionIDs map[string]bool
+}
+
+// LintContext lints a path, checking its PagerDuty automation actions
+// against the automation actions of the account.
+func (s *EventOrchestrationPathService) LintContext(ctx context.Context, path *EventOrchestrationPath) ([]*OrchestrationLintIssue, error) {
+	actions, _, err := s.client.AutomationActionsAction.ListContext(ctx, &ListAutomationActionsActionsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]bool)
+	for _, a := range actions.Actions {
+		ids[a.ID] = true
+	}
+	return LintEventOrchestrationPath(path, &OrchestrationLintOptions{AutomationActionIDs: ids}), nil
+}
+
+// LintEventOrchestrationPath runs static checks over a path and returns the
+// problems found, errors first.
+func LintEventOrchestrationPath(path *EventOrchestrationPath, o *OrchestrationLintOptions) []*OrchestrationLintIssue {
+	if o == nil {
+		o = &OrchestrationLintOptions{}
+	}
+	l := &orchestrationLinter{path: path, options: o, sets: make(map[string]bool), variables: make(map[string]bool)}
+
+	for _, set := range path.Sets {
+		l.sets[set.ID] = true
+		for _, rule := range set.Rules {
+			if rule.Actions != nil {
+				for _, v := range rule.Actions.Variables {
+					l.variables[v.Name] = true
+				}
+			}
+		}
+	}
+	if path.CatchAll != nil && path.CatchAll.Actions != nil {
+		for _, v := range path.CatchAll.Actions.Variables {
+			l.variables[v.Name] = true
+		}
+	}
+
+	for _, set := range path.Sets {
+		l.lintSet(set)
+	}
+	if path.CatchAll != nil && path.CatchAll.Actions != nil {
+		l.lintActions(&OrchestrationLintIssue{PathType: path.Type, CatchAll: true}, path.CatchAll.Actions)
+	}
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		return l.issues[i].Severity == OrchestrationLintError && l.issues[j].Severity != OrchestrationLintError
+	})
+	return l.issues
+}
+
+type orchestrationLinter struct {
+	path      *EventOrchestrationPath
+	options   *OrchestrationLintOptions
+	sets      map[string]bool
+	variables map[string]bool
+	issues    []*OrchestrationLintIssue
+}
+
+// report adds an issue at the location of loc.
+func (l *orchestrationLinter) report(loc *OrchestrationLintIssue, severity OrchestrationLintSeverity, check, format string, args ...interface{}) {
+	issue := *loc
+	issue.Severity = severity
+	issue.Check = check
+	issue.Message = fmt.Sprintf(format, args...)
+	l.issues = append(l.issues, &issue)
+}
+
+func (l *orchestrationLinter) lintSet(set *EventOrchestrationPathSet) {
+	var unconditional *EventOrchestrationPathRule
+	for i, rule := range set.Rules {
+		index := i
+		loc := &OrchestrationLintIssue{PathType: l.path.Type, SetID: set.ID, RuleID: rule.ID, RuleIndex: &index}
+
+		if unconditional != nil && !rule.Disabled {
+			l.report(loc, OrchestrationLintWarning, OrchestrationLintUnreachableRule,
+				"rule can never match because rule %s before it has no conditions", orchestrationRuleName(unconditional))
+		}
+		if len(rule.Conditions) == 0 && !rule.Disabled {
+			l.report(loc, OrchestrationLintWarning, OrchestrationLintEmptyConditions,
+				"rule has no conditions and matches every event; use the catch-all for that")
+			if unconditional == nil {
+				unconditional = rule
+			}
+		}
+
+		for _, c := range rule.Conditions {
+			expr, err := ParsePCL(c.Expression)
+			if err != nil {
+				l.report(loc, OrchestrationLintError, OrchestrationLintInvalidCondition, "invalid condition %q: %v", c.Expression, err)
+				continue
+			}
+			for _, p := range expr.FieldPaths() {
+				l.checkVariable(loc, p)
+			}
+		}
+
+		if rule.Actions == nil {
+			continue
+		}
+		if to := rule.Actions.RouteTo; to != "" && l.path.Type != PathTypeRouter {
+			if !l.sets[to] {
+				l.report(loc, OrchestrationLintError, OrchestrationLintUnknownSet, "route_to names set %q, which does not exist", to)
+			}
+		}
+		l.lintActions(loc, rule.Actions)
+	}
+}
+
+func orchestrationRuleName(rule *EventOrchestrationPathRule) string {
+	if rule.Label != "" {
+		return fmt.Sprintf("%s (%s)", rule.ID, rule.Label)
+	}
+	return rule.ID
+}
+
+func (l *orchestrationLinter) lintActions(loc *OrchestrationLintIssue, a *EventOrchestrationPathRuleActions) {
+	for _, v := range a.Variables {
+		l.checkVariable(loc, v.Path)
+		if v.Type == "regex" || v.Type == "" {
+			l.checkRegex(loc, fmt.Sprintf("variable %s", v.Name), v.Value)
+		}
+	}
+
+	for _, e := range a.Extractions {
+		if e.Template != "" {
+			for _, m := range orchestrationTemplateVar.FindAllStringSubmatch(e.Template, -1) {
+				l.checkVariable(loc, m[1])
+			}
+			continue
+		}
+		l.checkVariable(loc, e.Source)
+		l.checkRegex(loc, fmt.Sprintf("extraction to %s", e.Target), e.Regex)
+	}
+
+	if d := a.DynamicRouteTo; d != nil {
+		l.checkVariable(loc, d.Source)
+		l.checkRegex(loc, "dynamic_route_to", d.Regex)
+	}
+
+	if l.options.AutomationActionIDs != nil {
+		for _, pa := range a.PagerdutyAutomationActions {
+			if !l.options.AutomationActionIDs[pa.ActionId] {
+				l.report(loc, OrchestrationLintError, OrchestrationLintUnknownAutomationAction, "automation action %q does not exist", pa.ActionId)
+			}
+		}
+	}
+}
+
+func (l *orchestrationLinter) checkRegex(loc *OrchestrationLintIssue, what, regex string) {
+	if _, err := regexp.Compile(regex); err != nil {
+		l.report(loc, OrchestrationLintError, OrchestrationLintInvalidRegex, "invalid regex %q in %s: %v", regex, what, err)
+	}
+}
+
+// checkVariable reports a field path, such as variables.host, naming a
+// variable no rule of the path defines.
+func (l *orchestrationLinter) checkVariable(loc *OrchestrationLintIssue, path string) {
+	segs, err := splitOrchestrationPath(path)
+	if err != nil || segs[0] != "variables" {
+		return
+	}
+	if !l.variables[segs[1]] {
+		l.report(loc, OrchestrationLintError, OrchestrationLintUndefinedVariable, "variable %q is used but not defined in this path", segs[1])
+	}
+}
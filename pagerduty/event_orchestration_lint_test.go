@@ -0,0 +1,136 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func testLintPath() *EventOrchestrationPath {
+	return &EventOrchestrationPath{
+		Type: PathTypeGlobal,
+		Sets: []*EventOrchestrationPathSet{
+			{
+				ID: "start",
+				Rules: []*EventOrchestrationPathRule{
+					{
+						ID:         "r1",
+						Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "variables.team matches 'db'"}},
+						Actions: &EventOrchestrationPathRuleActions{
+							RouteTo: "missing",
+							Variables: []*EventOrchestrationPathActionVariables{
+								{Name: "host", Path: "event.source", Type: "regex", Value: "(.*"},
+							},
+						},
+					},
+					{
+						ID:         "r2",
+						Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.summary matches"}},
+						Actions: &EventOrchestrationPathRuleActions{
+							Extractions: []*EventOrchestrationPathActionExtractions{
+								{Target: "event.summary", Template: "{{variables.host}} on {{variables.region}}"},
+								{Target: "event.group", Source: "event.source", Regex: "[a-"},
+							},
+						},
+					},
+					{
+						ID:      "r3",
+						Label:   "Everything",
+						Actions: &EventOrchestrationPathRuleActions{RouteTo: "next"},
+					},
+					{
+						ID:         "r4",
+						Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.severity matches 'critical'"}},
+					},
+					{
+						ID:       "r5",
+						Disabled: true,
+					},
+				},
+			},
+			{
+				ID: "next",
+				Rules: []*EventOrchestrationPathRule{
+					{
+						ID:         "r6",
+						Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.source exists"}},
+						Actions: &EventOrchestrationPathRuleActions{
+							PagerdutyAutomationActions: []*EventOrchestrationPathPagerdutyAutomationAction{{ActionId: "A1"}, {ActionId: "A2"}},
+						},
+					},
+				},
+			},
+		},
+		CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{
+			DynamicRouteTo: &EventOrchestrationPathDynamicRouteTo{Source: "event.source", Regex: "(", LookupBy: "service_id"},
+		}},
+	}
+}
+
+func TestLintEventOrchestrationPath(t *testing.T) {
+	issues := LintEventOrchestrationPath(testLintPath(), &OrchestrationLintOptions{AutomationActionIDs: map[string]bool{"A1": true}})
+
+	var got []string
+	for _, i := range issues {
+		got = append(got, i.String())
+	}
+	want := []string{
+		`error: global set start rule r1: variable "team" is used but not defined in this path (undefined_variable)`,
+		`error: global set start rule r1: route_to names set "missing", which does not exist (unknown_set)`,
+		"error: global set start rule r1: invalid regex \"(.*\" in variable host: error parsing regexp: missing closing ): `(.*` (invalid_regex)",
+		`error: global set start rule r2: invalid condition "event.summary matches": pcl: expected a quoted string, found end of expression at line 1, column 22 (invalid_condition)`,
+		`error: global set start rule r2: variable "region" is used but not defined in this path (undefined_variable)`,
+		"error: global set start rule r2: invalid regex \"[a-\" in extraction to event.group: error parsing regexp: missing closing ]: `[a-` (invalid_regex)",
+		`error: global set next rule r6: automation action "A2" does not exist (unknown_automation_action)`,
+		"error: global catch_all: invalid regex \"(\" in dynamic_route_to: error parsing regexp: missing closing ): `(` (invalid_regex)",
+		`warning: global set start rule r3: rule has no conditions and matches every event; use the catch-all for that (empty_conditions)`,
+		`warning: global set start rule r4: rule can never match because rule r3 (Everything) before it has no conditions (unreachable_rule)`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("issues:\n%q\nwant:\n%q", got, want)
+	}
+
+	if issues[0].SetID != "start" || issues[0].RuleID != "r1" || issues[0].RuleIndex == nil || *issues[0].RuleIndex != 0 {
+		t.Errorf("unexpected location %+v", issues[0])
+	}
+	for _, issue := range issues {
+		if issue.CatchAll && issue.RuleIndex != nil {
+			t.Errorf("catch-all issue has rule index %d", *issue.RuleIndex)
+		}
+	}
+}
+
+func TestLintEventOrchestrationPathClean(t *testing.T) {
+	path := &EventOrchestrationPath{
+		Type: PathTypeRouter,
+		Sets: []*EventOrchestrationPathSet{{ID: "start", Rules: []*EventOrchestrationPathRule{
+			{ID: "r1", Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.source exists"}}, Actions: &EventOrchestrationPathRuleActions{RouteTo: "PSERVICE"}},
+		}}},
+		CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{RouteTo: "unrouted"}},
+	}
+	if issues := LintEventOrchestrationPath(path, nil); len(issues) != 0 {
+		t.Errorf("unexpected issues %v", issues)
+	}
+}
+
+func TestEventOrchestrationPathLint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/automation_actions/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"actions": [{"id": "A1"}, {"id": "A2"}]}`))
+	})
+
+	path := testLintPath()
+	path.Sets = path.Sets[1:]
+	path.CatchAll = nil
+	issues, err := client.EventOrchestrationPaths.LintContext(context.Background(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("unexpected issues %v", issues)
+	}
+}
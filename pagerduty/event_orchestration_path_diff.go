@@ -0,0 +1,426 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Kinds of change reported by an EventOrchestrationPathDiff.
+const (
+	OrchestrationDiffAdded    = "added"
+	OrchestrationDiffRemoved  = "removed"
+	OrchestrationDiffModified = "modified"
+)
+
+// EventOrchestrationPathDiff is the structural difference between two
+// versions of an orchestration path. It marshals to JSON for tools and its
+// String method renders it as text for people.
+type EventOrchestrationPathDiff struct {
+	Sets     []*EventOrchestrationSetDiff     `json:"sets,omitempty"`
+	CatchAll []*EventOrchestrationFieldChange `json:"catch_all,omitempty"`
+}
+
+// EventOrchestrationSetDiff describes a set that was added, removed or has
+// changed rules. Sets are matched by ID.
+type EventOrchestrationSetDiff struct {
+	ID     string                        `json:"id"`
+	Change string                        `json:"change"`
+	Rules  []*EventOrchestrationRuleDiff `json:"rules,omitempty"`
+}
+
+// EventOrchestrationRuleDiff describes a rule that was added, removed,
+// moved or changed. Rules are matched by ID, then by label, so that a rule
+// recreated with a new ID is still compared to its previous version.
+// Positions are 0-based indexes in the set.
+type EventOrchestrationRuleDiff struct {
+	ID    string `json:"id,omitempty"`
+	Label string `json:"label,omitempty"`
+
+	Change      string `json:"change"`
+	OldPosition *int   `json:"old_position,omitempty"`
+	NewPosition *int   `json:"new_position,omitempty"`
+
+	// Reordered is set when the rule moved relative to the other rules
+	// of the set, not merely because rules were added or removed before it.
+	Reordered bool `json:"reordered,omitempty"`
+
+	AddedConditions   []string                         `json:"added_conditions,omitempty"`
+	RemovedConditions []string                         `json:"removed_conditions,omitempty"`
+	Fields            []*EventOrchestrationFieldChange `json:"fields,omitempty"`
+}
+
+// EventOrchestrationFieldChange is a changed field, such as
+// actions.severity, with its JSON values before and after. A missing
+// value means the field was unset.
+type EventOrchestrationFieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old,omitempty"`
+	New   interface{} `json:"new,omitempty"`
+}
+
+// DiffEventOrchestrationPaths compares two versions of a path. Either may
+// be nil.
+func DiffEventOrchestrationPaths(from, to *EventOrchestrationPath) *EventOrchestrationPathDiff {
+	if from == nil {
+		from = &EventOrchestrationPath{}
+	}
+	if to == nil {
+		to = &EventOrchestrationPath{}
+	}
+	d := &EventOrchestrationPathDiff{}
+
+	toSets := make(map[string]*EventOrchestrationPathSet)
+	for _, s := range to.Sets {
+		toSets[s.ID] = s
+	}
+	fromSets := make(map[string]*EventOrchestrationPathSet)
+	for _, s := range from.Sets {
+		fromSets[s.ID] = s
+		if _, ok := toSets[s.ID]; !ok {
+			d.Sets = append(d.Sets, &EventOrchestrationSetDiff{
+				ID:     s.ID,
+				Change: OrchestrationDiffRemoved,
+				Rules:  diffOrchestrationRules(s.Rules, nil),
+			})
+		}
+	}
+	for _, s := range to.Sets {
+		old, ok := fromSets[s.ID]
+		if !ok {
+			d.Sets = append(d.Sets, &EventOrchestrationSetDiff{
+				ID:     s.ID,
+				Change: OrchestrationDiffAdded,
+				Rules:  diffOrchestrationRules(nil, s.Rules),
+			})
+			continue
+		}
+		if rules := diffOrchestrationRules(old.Rules, s.Rules); len(rules) > 0 {
+			d.Sets = append(d.Sets, &EventOrchestrationSetDiff{
+				ID:     s.ID,
+				Change: OrchestrationDiffModified,
+				Rules:  rules,
+			})
+		}
+	}
+
+	var fromActions, toActions *EventOrchestrationPathRuleActions
+	if from.CatchAll != nil {
+		fromActions = from.CatchAll.Actions
+	}
+	if to.CatchAll != nil {
+		toActions = to.CatchAll.Actions
+	}
+	d.CatchAll = diffOrchestrationFields("actions", fromActions, toActions)
+
+	return d
+}
+
+// Empty reports whether the two paths are equivalent.
+func (d *EventOrchestrationPathDiff) Empty() bool {
+	return len(d.Sets) == 0 && len(d.CatchAll) == 0
+}
+
+func diffOrchestrationRules(from, to []*EventOrchestrationPathRule) []*EventOrchestrationRuleDiff {
+	// match[i] is the index in to of the rule matching from[i], or -1.
+	match := make([]int, len(from))
+	matched := make([]bool, len(to))
+	for i := range match {
+		match[i] = -1
+	}
+	for i, r := range from {
+		if r.ID == "" {
+			continue
+		}
+		for j, n := range to {
+			if !matched[j] && n.ID == r.ID {
+				match[i], matched[j] = j, true
+				break
+			}
+		}
+	}
+	for i, r := range from {
+		if match[i] >= 0 || r.Label == "" {
+			continue
+		}
+		for j, n := range to {
+			if !matched[j] && n.Label == r.Label {
+				match[i], matched[j] = j, true
+				break
+			}
+		}
+	}
+
+	// The matched rules forming the longest run still in their previous
+	// order stayed in place; the others moved relative to them.
+	var newOrder, oldIndexes []int
+	for j := range to {
+		if matched[j] {
+			newOrder = append(newOrder, j)
+		}
+	}
+	for _, j := range newOrder {
+		for i := range from {
+			if match[i] == j {
+				oldIndexes = append(oldIndexes, i)
+				break
+			}
+		}
+	}
+	stayed := make(map[int]bool)
+	for k, ok := range longestIncreasingRun(oldIndexes) {
+		if ok {
+			stayed[newOrder[k]] = true
+		}
+	}
+
+	var diffs []*EventOrchestrationRuleDiff
+	for i, r := range from {
+		oldPos := i
+		j := match[i]
+		if j < 0 {
+			diffs = append(diffs, &EventOrchestrationRuleDiff{
+				ID:          r.ID,
+				Label:       r.Label,
+				Change:      OrchestrationDiffRemoved,
+				OldPosition: &oldPos,
+			})
+			continue
+		}
+
+		newPos := j
+		n := to[j]
+		rd := &EventOrchestrationRuleDiff{
+			ID:          n.ID,
+			Label:       n.Label,
+			Change:      OrchestrationDiffModified,
+			OldPosition: &oldPos,
+			NewPosition: &newPos,
+			Reordered:   !stayed[j],
+		}
+
+		rd.RemovedConditions, rd.AddedConditions = diffOrchestrationConditions(r.Conditions, n.Conditions)
+		if r.Label != n.Label {
+			rd.Fields = append(rd.Fields, &EventOrchestrationFieldChange{Field: "label", Old: r.Label, New: n.Label})
+		}
+		if r.Disabled != n.Disabled {
+			rd.Fields = append(rd.Fields, &EventOrchestrationFieldChange{Field: "disabled", Old: r.Disabled, New: n.Disabled})
+		}
+		rd.Fields = append(rd.Fields, diffOrchestrationFields("actions", r.Actions, n.Actions)...)
+
+		if rd.Reordered || len(rd.AddedConditions) > 0 || len(rd.RemovedConditions) > 0 || len(rd.Fields) > 0 {
+			diffs = append(diffs, rd)
+		}
+	}
+
+	for j, n := range to {
+		if matched[j] {
+			continue
+		}
+		newPos := j
+		diffs = append(diffs, &EventOrchestrationRuleDiff{
+			ID:          n.ID,
+			Label:       n.Label,
+			Change:      OrchestrationDiffAdded,
+			NewPosition: &newPos,
+		})
+	}
+
+	return diffs
+}
+
+// longestIncreasingRun marks the elements of seq that form one of its
+// longest increasing subsequences. Among equally long ones it keeps the
+// elements that come last, so that an element brought forward is the one
+// reported as moved.
+func longestIncreasingRun(seq []int) []bool {
+	length := make([]int, len(seq))
+	prev := make([]int, len(seq))
+	best := -1
+	for i := range seq {
+		length[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if seq[j] < seq[i] && length[j]+1 >= length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+		if best < 0 || length[i] > length[best] {
+			best = i
+		}
+	}
+
+	in := make([]bool, len(seq))
+	for i := best; i >= 0; i = prev[i] {
+		in[i] = true
+	}
+	return in
+}
+
+// diffOrchestrationConditions compares conditions as a set of expressions,
+// since a rule matches if any of them does.
+func diffOrchestrationConditions(from, to []*EventOrchestrationPathRuleCondition) (removed, added []string) {
+	count := make(map[string]int)
+	for _, c := range from {
+		count[c.Expression]++
+	}
+	for _, c := range to {
+		if count[c.Expression] > 0 {
+			count[c.Expression]--
+			continue
+		}
+		added = append(added, c.Expression)
+	}
+	for _, c := range from {
+		if count[c.Expression] > 0 {
+			count[c.Expression]--
+			removed = append(removed, c.Expression)
+		}
+	}
+	return removed, added
+}
+
+// diffOrchestrationFields compares the JSON fields of two values. Unset,
+// null, false, empty and zero fields are considered equal.
+func diffOrchestrationFields(prefix string, from, to interface{}) []*EventOrchestrationFieldChange {
+	a, b := orchestrationJSONFields(from), orchestrationJSONFields(to)
+
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []*EventOrchestrationFieldChange
+	for _, k := range keys {
+		if !reflect.DeepEqual(a[k], b[k]) {
+			changes = append(changes, &EventOrchestrationFieldChange{Field: prefix + "." + k, Old: a[k], New: b[k]})
+		}
+	}
+	return changes
+}
+
+func orchestrationJSONFields(v interface{}) map[string]interface{} {
+	fields := make(map[string]interface{})
+	if v == nil || reflect.ValueOf(v).IsNil() {
+		return fields
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fields
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fields
+	}
+	for k, e := range m {
+		if !isZeroOrchestrationJSON(e) {
+			fields[k] = e
+		}
+	}
+	return fields
+}
+
+func isZeroOrchestrationJSON(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// String renders the diff as text, one change per line:
+//
+//	set start
+//	  + rule "Disk alerts" (r1) at 0
+//	  ~ rule "Databases" (r2) moved 1 -> 0
+//	      - condition event.source matches 'db'
+//	      + condition event.source matches part 'db'
+//	      actions.severity: "warning" -> "critical"
+//	catch_all
+//	  actions.suppress: unset -> true
+func (d *EventOrchestrationPathDiff) String() string {
+	var b strings.Builder
+	for _, s := range d.Sets {
+		switch s.Change {
+		case OrchestrationDiffAdded:
+			fmt.Fprintf(&b, "+ set %s\n", s.ID)
+		case OrchestrationDiffRemoved:
+			fmt.Fprintf(&b, "- set %s\n", s.ID)
+		default:
+			fmt.Fprintf(&b, "set %s\n", s.ID)
+		}
+		for _, r := range s.Rules {
+			writeOrchestrationRuleDiff(&b, r)
+		}
+	}
+	if len(d.CatchAll) > 0 {
+		b.WriteString("catch_all\n")
+		for _, f := range d.CatchAll {
+			fmt.Fprintf(&b, "  %s\n", f)
+		}
+	}
+	return b.String()
+}
+
+func writeOrchestrationRuleDiff(b *strings.Builder, r *EventOrchestrationRuleDiff) {
+	name := fmt.Sprintf("rule %q", r.Label)
+	if r.ID != "" {
+		name += " (" + r.ID + ")"
+	}
+
+	switch r.Change {
+	case OrchestrationDiffAdded:
+		fmt.Fprintf(b, "  + %s at %d\n", name, *r.NewPosition)
+		return
+	case OrchestrationDiffRemoved:
+		fmt.Fprintf(b, "  - %s at %d\n", name, *r.OldPosition)
+		return
+	}
+
+	if r.Reordered {
+		fmt.Fprintf(b, "  ~ %s moved %d -> %d\n", name, *r.OldPosition, *r.NewPosition)
+	} else {
+		fmt.Fprintf(b, "  ~ %s\n", name)
+	}
+	for _, c := range r.RemovedConditions {
+		fmt.Fprintf(b, "      - condition %s\n", c)
+	}
+	for _, c := range r.AddedConditions {
+		fmt.Fprintf(b, "      + condition %s\n", c)
+	}
+	for _, f := range r.Fields {
+		fmt.Fprintf(b, "      %s\n", f)
+	}
+}
+
+func (f *EventOrchestrationFieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", f.Field, orchestrationDiffValue(f.Old), orchestrationDiffValue(f.New))
+}
+
+func orchestrationDiffValue(v interface{}) string {
+	if v == nil {
+		return "unset"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
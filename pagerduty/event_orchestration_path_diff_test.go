@@ -0,0 +1,151 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testDiffPaths() (*EventOrchestrationPath, *EventOrchestrationPath) {
+	from := &EventOrchestrationPath{
+		Type: PathTypeService,
+		Sets: []*EventOrchestrationPathSet{
+			{
+				ID: "start",
+				Rules: []*EventOrchestrationPathRule{
+					{
+						ID:         "r1",
+						Label:      "Disk alerts",
+						Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.summary matches part 'disk'"}},
+						Actions:    &EventOrchestrationPathRuleActions{Severity: "warning"},
+					},
+					{
+						ID:         "r2",
+						Label:      "Databases",
+						Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.source matches 'db'"}},
+						Actions:    &EventOrchestrationPathRuleActions{Severity: "warning", RouteTo: "db"},
+					},
+					{
+						ID:      "r3",
+						Label:   "Noise",
+						Actions: &EventOrchestrationPathRuleActions{Suppress: true},
+					},
+				},
+			},
+			{ID: "db", Rules: []*EventOrchestrationPathRule{}},
+			{ID: "old", Rules: []*EventOrchestrationPathRule{{ID: "r4", Label: "Old"}}},
+		},
+		CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{}},
+	}
+
+	to := &EventOrchestrationPath{
+		Type: PathTypeService,
+		Sets: []*EventOrchestrationPathSet{
+			{
+				ID: "start",
+				Rules: []*EventOrchestrationPathRule{
+					{
+						// Recreated with a new ID, matched by label.
+						ID:         "r9",
+						Label:      "Databases",
+						Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.source matches part 'db'"}},
+						Actions:    &EventOrchestrationPathRuleActions{Severity: "critical", RouteTo: "db", Variables: []*EventOrchestrationPathActionVariables{}},
+					},
+					{
+						ID:         "r1",
+						Label:      "Disk alerts",
+						Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.summary matches part 'disk'"}},
+						Actions:    &EventOrchestrationPathRuleActions{Severity: "warning"},
+					},
+					{
+						ID:       "r5",
+						Label:    "Heartbeats",
+						Disabled: true,
+					},
+					{
+						ID:       "r3",
+						Label:    "Noise",
+						Actions:  &EventOrchestrationPathRuleActions{Suppress: true},
+						Disabled: true,
+					},
+				},
+			},
+			{ID: "db", Rules: []*EventOrchestrationPathRule{}},
+			{ID: "new", Rules: []*EventOrchestrationPathRule{{ID: "r6", Label: "New"}}},
+		},
+		CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{Suppress: true}},
+	}
+
+	return from, to
+}
+
+func TestDiffEventOrchestrationPathsText(t *testing.T) {
+	from, to := testDiffPaths()
+	d := DiffEventOrchestrationPaths(from, to)
+
+	want := `- set old
+  - rule "Old" (r4) at 0
+set start
+  ~ rule "Databases" (r9) moved 1 -> 0
+      - condition event.source matches 'db'
+      + condition event.source matches part 'db'
+      actions.severity: "warning" -> "critical"
+  ~ rule "Noise" (r3)
+      disabled: false -> true
+  + rule "Heartbeats" (r5) at 2
++ set new
+  + rule "New" (r6) at 0
+catch_all
+  actions.suppress: unset -> true
+`
+	if got := d.String(); got != want {
+		t.Errorf("diff:\n%s\nwant:\n%s", got, want)
+	}
+	if d.Empty() {
+		t.Error("diff should not be empty")
+	}
+}
+
+func TestDiffEventOrchestrationPathsJSON(t *testing.T) {
+	from, to := testDiffPaths()
+	d := DiffEventOrchestrationPaths(from, to)
+
+	data, err := json.Marshal(d.Sets[1].Rules[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":"r9","label":"Databases","change":"modified","old_position":1,"new_position":0,"reordered":true,` +
+		`"added_conditions":["event.source matches part 'db'"],"removed_conditions":["event.source matches 'db'"],` +
+		`"fields":[{"field":"actions.severity","old":"warning","new":"critical"}]}`
+	if string(data) != want {
+		t.Errorf("JSON %s; want %s", data, want)
+	}
+}
+
+func TestDiffEventOrchestrationPathsSingleMove(t *testing.T) {
+	path := func(ids ...string) *EventOrchestrationPath {
+		set := &EventOrchestrationPathSet{ID: "start"}
+		for _, id := range ids {
+			set.Rules = append(set.Rules, &EventOrchestrationPathRule{ID: id, Label: id})
+		}
+		return &EventOrchestrationPath{Sets: []*EventOrchestrationPathSet{set}}
+	}
+
+	d := DiffEventOrchestrationPaths(path("A", "B", "C", "D"), path("D", "A", "B", "C"))
+	want := "set start\n  ~ rule \"D\" (D) moved 3 -> 0\n"
+	if got := d.String(); got != want {
+		t.Errorf("diff:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDiffEventOrchestrationPathsEqual(t *testing.T) {
+	from, _ := testDiffPaths()
+	other, _ := testDiffPaths()
+	other.CatchAll = nil
+
+	if d := DiffEventOrchestrationPaths(from, other); !d.Empty() {
+		t.Errorf("expected no differences, got:\n%s", d)
+	}
+	if d := DiffEventOrchestrationPaths(nil, nil); !d.Empty() {
+		t.Errorf("expected no differences, got:\n%s", d)
+	}
+}
@@ -0,0 +1,794 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// OrchestrationEvent is a PD-CEF event as seen by Event Orchestration
+// conditions, actions and cache variables.
+type OrchestrationEvent struct {
+	Summary       string                 `json:"summary,omitempty"`
+	Source        string                 `json:"source,omitempty"`
+	Severity      string                 `json:"severity,omitempty"`
+	Timestamp     string                 `json:"timestamp,omitempty"`
+	Component     string                 `json:"component,omitempty"`
+	Group         string                 `json:"group,omitempty"`
+	Class         string                 `json:"class,omitempty"`
+	EventAction   string                 `json:"event_action,omitempty"`
+	DedupKey      string                 `json:"dedup_key,omitempty"`
+	CustomDetails map[string]interface{} `json:"custom_details,omitempty"`
+
+	// RawEvent is the event as it was sent to PagerDuty, before it was
+	// normalized to PD-CEF. It is matched by raw_event.* fields.
+	RawEvent map[string]interface{} `json:"raw_event,omitempty"`
+}
+
+// field returns the value of a PD-CEF field of the event.
+func (e *OrchestrationEvent) field(name string) (interface{}, bool) {
+	var v string
+	switch name {
+	case "summary":
+		v = e.Summary
+	case "source":
+		v = e.Source
+	case "severity":
+		v = e.Severity
+	case "timestamp":
+		v = e.Timestamp
+	case "component":
+		v = e.Component
+	case "group":
+		v = e.Group
+	case "class":
+		v = e.Class
+	case "event_action":
+		v = e.EventAction
+	case "dedup_key":
+		v = e.DedupKey
+	case "custom_details":
+		if e.CustomDetails == nil {
+			return nil, false
+		}
+		return e.CustomDetails, true
+	default:
+		return nil, false
+	}
+	return v, v != ""
+}
+
+// PCLContext holds what a PCL expression is evaluated against.
+type PCLContext struct {
+	Event *OrchestrationEvent
+
+	// Variables holds the values set by the Variables action of earlier
+	// rules, matched by variables.* fields.
+	Variables map[string]interface{}
+
+	// CacheVariables holds the current values of cache variables, matched by
+	// cache_var.* fields.
+	CacheVariables map[string]interface{}
+
+	// Now is the time used by now conditions. The current time is used when
+	// it is zero.
+	Now time.Time
+}
+
+// lookup resolves a field path against the context.
+func (c *PCLContext) lookup(path []string) (interface{}, bool) {
+	var cur interface{}
+	switch path[0] {
+	case "event":
+		if c.Event == nil {
+			return nil, false
+		}
+		v, ok := c.Event.field(path[1])
+		if !ok {
+			return nil, false
+		}
+		cur, path = v, path[2:]
+	case "raw_event":
+		if c.Event == nil || c.Event.RawEvent == nil {
+			return nil, false
+		}
+		cur, path = c.Event.RawEvent, path[1:]
+	case "variables":
+		cur, path = c.Variables, path[1:]
+	case "cache_var":
+		cur, path = c.CacheVariables, path[1:]
+	default:
+		return nil, false
+	}
+
+	for _, seg := range path {
+		switch m := cur.(type) {
+		case map[string]interface{}:
+			v, ok := m[seg]
+			if !ok {
+				return nil, false
+			}
+			cur = v
+		case []interface{}:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(m) {
+				return nil, false
+			}
+			cur = m[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return cur, cur != nil
+}
+
+// pclEventFields lists the PD-CEF fields that can follow "event.".
+var pclEventFields = map[string]bool{
+	"summary":        true,
+	"source":         true,
+	"severity":       true,
+	"timestamp":      true,
+	"component":      true,
+	"group":          true,
+	"class":          true,
+	"event_action":   true,
+	"dedup_key":      true,
+	"custom_details": true,
+}
+
+// PCLSyntaxError is returned by ParsePCL for an invalid expression. Line and
+// Column are 1-based and Offset is the byte offset in the expression.
+type PCLSyntaxError struct {
+	Expression string
+	Offset     int
+	Line       int
+	Column     int
+	Message    string
+}
+
+func (e *PCLSyntaxError) Error() string {
+	return fmt.Sprintf("pcl: %s at line %d, column %d", e.Message, e.Line, e.Column)
+}
+
+// PCLExpression is a parsed PCL condition.
+type PCLExpression struct {
+	source string
+	root   pclNode
+}
+
+// ParsePCL parses a PCL expression such as the Expression of an
+// EventOrchestrationPathRuleCondition. It supports the matches, matches part,
+// matches regex and exists operators on event.*, raw_event.*, variables.*
+// and cache_var.* fields, the now in operator with weekly or absolute time
+// ranges, and not, and, or and parentheses.
+func ParsePCL(expression string) (*PCLExpression, error) {
+	p := &pclParser{src: expression}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != pclTokenEOF {
+		return nil, p.errorf(t.pos, "unexpected %s", t)
+	}
+
+	return &PCLExpression{source: expression, root: root}, nil
+}
+
+// String returns the expression as it was parsed.
+func (e *PCLExpression) String() string {
+	return e.source
+}
+
+// Evaluate reports whether the expression matches in the given context.
+func (e *PCLExpression) Evaluate(ctx *PCLContext) (bool, error) {
+	if ctx == nil {
+		ctx = &PCLContext{}
+	}
+	return e.root.eval(ctx)
+}
+
+// FieldPaths returns the field paths referenced by the expression, such as
+// "event.summary" or "variables.region", sorted and without duplicates.
+func (e *PCLExpression) FieldPaths() []string {
+	seen := make(map[string]bool)
+	var walk func(n pclNode)
+	walk = func(n pclNode) {
+		switch n := n.(type) {
+		case *pclBinary:
+			walk(n.left)
+			walk(n.right)
+		case *pclNot:
+			walk(n.x)
+		case *pclPredicate:
+			seen[strings.Join(n.path, ".")] = true
+		}
+	}
+	walk(e.root)
+
+	paths := make([]string, 0, len(seen))
+	for p := range seen {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// EvaluatePCLConditions evaluates conditions the way Event Orchestration
+// does: a rule without conditions always matches, otherwise it matches when
+// any of its conditions does.
+func EvaluatePCLConditions(conditions []*EventOrchestrationPathRuleCondition, ctx *PCLContext) (bool, error) {
+	if len(conditions) == 0 {
+		return true, nil
+	}
+	for _, c := range conditions {
+		expr, err := ParsePCL(c.Expression)
+		if err != nil {
+			return false, err
+		}
+		ok, err := expr.Evaluate(ctx)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type pclNode interface {
+	eval(ctx *PCLContext) (bool, error)
+}
+
+type pclBinary struct {
+	and         bool
+	left, right pclNode
+}
+
+func (n *pclBinary) eval(ctx *PCLContext) (bool, error) {
+	l, err := n.left.eval(ctx)
+	if err != nil {
+		return false, err
+	}
+	if l != n.and {
+		return l, nil
+	}
+	return n.right.eval(ctx)
+}
+
+type pclNot struct {
+	x pclNode
+}
+
+func (n *pclNot) eval(ctx *PCLContext) (bool, error) {
+	v, err := n.x.eval(ctx)
+	return !v, err
+}
+
+type pclOperator int
+
+const (
+	pclExists pclOperator = iota
+	pclMatches
+	pclMatchesPart
+	pclMatchesRegex
+)
+
+type pclPredicate struct {
+	path  []string
+	op    pclOperator
+	value string
+	re    *regexp.Regexp
+}
+
+func (n *pclPredicate) eval(ctx *PCLContext) (bool, error) {
+	v, ok := ctx.lookup(n.path)
+	if !ok {
+		return false, nil
+	}
+
+	switch n.op {
+	case pclExists:
+		return true, nil
+	case pclMatches:
+		return pclString(v) == n.value, nil
+	case pclMatchesPart:
+		return strings.Contains(strings.ToLower(pclString(v)), strings.ToLower(n.value)), nil
+	case pclMatchesRegex:
+		return n.re.MatchString(pclString(v)), nil
+	}
+	return false, fmt.Errorf("pcl: unknown operator %d", n.op)
+}
+
+// pclString returns the text a field value is matched as.
+func pclString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+type pclNowIn struct {
+	loc *time.Location
+
+	// Weekly ranges repeat on the given days between two times of day,
+	// given as seconds since midnight.
+	weekly     bool
+	days       [7]bool
+	start, end int
+
+	// Absolute ranges are between two instants.
+	from, to time.Time
+}
+
+func (n *pclNowIn) eval(ctx *PCLContext) (bool, error) {
+	now := ctx.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	now = now.In(n.loc)
+
+	if !n.weekly {
+		return !now.Before(n.from) && now.Before(n.to), nil
+	}
+
+	secs := now.Hour()*3600 + now.Minute()*60 + now.Second()
+	if n.start <= n.end {
+		return n.days[now.Weekday()] && secs >= n.start && secs < n.end, nil
+	}
+	// The range crosses midnight: it started on a listed day and runs into
+	// the next one.
+	if secs >= n.start {
+		return n.days[now.Weekday()], nil
+	}
+	if secs < n.end {
+		return n.days[(now.Weekday()+6)%7], nil
+	}
+	return false, nil
+}
+
+type pclTokenKind int
+
+const (
+	pclTokenEOF pclTokenKind = iota
+	pclTokenWord
+	pclTokenString
+	pclTokenLParen
+	pclTokenRParen
+)
+
+type pclToken struct {
+	kind pclTokenKind
+	text string
+	pos  int
+
+	// path holds the segments of a word that is a field path.
+	path []string
+}
+
+func (t pclToken) String() string {
+	switch t.kind {
+	case pclTokenEOF:
+		return "end of expression"
+	case pclTokenString:
+		return fmt.Sprintf("string %q", t.text)
+	case pclTokenLParen:
+		return `"("`
+	case pclTokenRParen:
+		return `")"`
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+func (t pclToken) is(keyword string) bool {
+	return t.kind == pclTokenWord && strings.EqualFold(t.text, keyword)
+}
+
+type pclParser struct {
+	src    string
+	tokens []pclToken
+	i      int
+}
+
+func (p *pclParser) errorf(pos int, format string, args ...interface{}) error {
+	line, col := 1, 1
+	for _, r := range p.src[:pos] {
+		if r == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return &PCLSyntaxError{
+		Expression: p.src,
+		Offset:     pos,
+		Line:       line,
+		Column:     col,
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *pclParser) tokenize() error {
+	s := p.src
+	i := 0
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(':
+			p.tokens = append(p.tokens, pclToken{kind: pclTokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			p.tokens = append(p.tokens, pclToken{kind: pclTokenRParen, text: ")", pos: i})
+			i++
+		case r == '\'' || r == '"':
+			text, n, err := p.readString(i)
+			if err != nil {
+				return err
+			}
+			p.tokens = append(p.tokens, pclToken{kind: pclTokenString, text: text, pos: i})
+			i += n
+		default:
+			tok, n, err := p.readWord(i)
+			if err != nil {
+				return err
+			}
+			p.tokens = append(p.tokens, tok)
+			i += n
+		}
+	}
+	p.tokens = append(p.tokens, pclToken{kind: pclTokenEOF, pos: len(s)})
+	return nil
+}
+
+// readString reads a quoted string starting at pos and returns its text and
+// its length in the source. Only the quote character is escaped by a
+// backslash; other backslashes are kept so that regexes read naturally.
+func (p *pclParser) readString(pos int) (string, int, error) {
+	quote := p.src[pos]
+	var b strings.Builder
+	for i := pos + 1; i < len(p.src); i++ {
+		c := p.src[i]
+		switch {
+		case c == '\\':
+			if i+1 >= len(p.src) {
+				return "", 0, p.errorf(pos, "unterminated string")
+			}
+			i++
+			if p.src[i] != quote {
+				b.WriteByte(c)
+			}
+			b.WriteByte(p.src[i])
+		case c == quote:
+			return b.String(), i + 1 - pos, nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, p.errorf(pos, "unterminated string")
+}
+
+// readWord reads a keyword, field path or time range element. Field paths
+// may use ['key'] segments for keys that are not plain identifiers.
+func (p *pclParser) readWord(pos int) (pclToken, int, error) {
+	var path []string
+	var seg strings.Builder
+	isPath := true
+	i := pos
+loop:
+	for i < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[i:])
+		switch {
+		case unicode.IsSpace(r) || r == '(' || r == ')' || r == '\'' || r == '"':
+			break loop
+		case r == '[':
+			if i+1 >= len(p.src) || (p.src[i+1] != '\'' && p.src[i+1] != '"') {
+				return pclToken{}, 0, p.errorf(i, "expected a quoted key after \"[\"")
+			}
+			if seg.Len() > 0 {
+				path = append(path, seg.String())
+				seg.Reset()
+			}
+			key, n, err := p.readString(i + 1)
+			if err != nil {
+				return pclToken{}, 0, err
+			}
+			i += 1 + n
+			if i >= len(p.src) || p.src[i] != ']' {
+				return pclToken{}, 0, p.errorf(i, "expected \"]\"")
+			}
+			i++
+			path = append(path, key)
+			if i < len(p.src) && p.src[i] == '.' {
+				i++
+			}
+			continue
+		case r == '.':
+			if seg.Len() == 0 {
+				isPath = false
+			}
+			path = append(path, seg.String())
+			seg.Reset()
+		default:
+			if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+				isPath = false
+			}
+			seg.WriteRune(r)
+		}
+		i += size
+	}
+	if seg.Len() > 0 || strings.HasSuffix(p.src[pos:i], ".") {
+		path = append(path, seg.String())
+	}
+
+	tok := pclToken{kind: pclTokenWord, text: p.src[pos:i], pos: pos}
+	if isPath && len(path) > 1 {
+		tok.path = path
+	}
+	return tok, i - pos, nil
+}
+
+func (p *pclParser) peek() pclToken {
+	return p.tokens[p.i]
+}
+
+func (p *pclParser) next() pclToken {
+	t := p.tokens[p.i]
+	if t.kind != pclTokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *pclParser) parseOr() (pclNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &pclBinary{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *pclParser) parseAnd() (pclNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &pclBinary{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *pclParser) parseUnary() (pclNode, error) {
+	if p.peek().is("not") {
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &pclNot{x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *pclParser) parsePrimary() (pclNode, error) {
+	t := p.next()
+	switch {
+	case t.kind == pclTokenLParen:
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if c := p.next(); c.kind != pclTokenRParen {
+			return nil, p.errorf(c.pos, "expected \")\", found %s", c)
+		}
+		return x, nil
+	case t.is("now"):
+		return p.parseNowIn()
+	case t.kind == pclTokenWord && t.path != nil:
+		return p.parsePredicate(t)
+	case t.kind == pclTokenEOF:
+		return nil, p.errorf(t.pos, "unexpected end of expression, expected a condition")
+	}
+	return nil, p.errorf(t.pos, "expected a field path, \"now\", \"not\" or \"(\", found %s", t)
+}
+
+func (p *pclParser) parsePredicate(field pclToken) (pclNode, error) {
+	if err := p.checkPath(field); err != nil {
+		return nil, err
+	}
+	n := &pclPredicate{path: field.path}
+
+	t := p.next()
+	switch {
+	case t.is("exists"):
+		n.op = pclExists
+		return n, nil
+	case t.is("matches"):
+	default:
+		return nil, p.errorf(t.pos, "expected \"matches\" or \"exists\" after %s, found %s", field, t)
+	}
+
+	n.op = pclMatches
+	if p.peek().is("part") {
+		p.next()
+		n.op = pclMatchesPart
+	} else if p.peek().is("regex") {
+		p.next()
+		n.op = pclMatchesRegex
+	}
+
+	v := p.next()
+	if v.kind != pclTokenString {
+		return nil, p.errorf(v.pos, "expected a quoted string, found %s", v)
+	}
+	n.value = v.text
+	if n.op == pclMatchesRegex {
+		re, err := regexp.Compile(v.text)
+		if err != nil {
+			return nil, p.errorf(v.pos, "invalid regex: %v", err)
+		}
+		n.re = re
+	}
+	return n, nil
+}
+
+// checkPath rejects field paths no event can ever have, so that typos do not
+// silently never match.
+func (p *pclParser) checkPath(t pclToken) error {
+	switch t.path[0] {
+	case "event":
+		if !pclEventFields[t.path[1]] {
+			return p.errorf(t.pos, "unknown event field %q", t.path[1])
+		}
+		if t.path[1] != "custom_details" && len(t.path) > 2 {
+			return p.errorf(t.pos, "event field %q has no sub-fields", t.path[1])
+		}
+	case "raw_event", "variables", "cache_var":
+	default:
+		return p.errorf(t.pos, "unknown field namespace %q, expected event, raw_event, variables or cache_var", t.path[0])
+	}
+	for _, seg := range t.path {
+		if seg == "" {
+			return p.errorf(t.pos, "empty segment in field path %q", t.text)
+		}
+	}
+	return nil
+}
+
+var pclWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// parseNowIn parses the range following "now", either weekly as in
+// "in Mon,Tue 09:00:00 to 17:00:00 America/New_York" or absolute as in
+// "in 2024-01-01 00:00:00 to 2024-01-02 00:00:00 UTC".
+func (p *pclParser) parseNowIn() (pclNode, error) {
+	if t := p.next(); !t.is("in") {
+		return nil, p.errorf(t.pos, "expected \"in\" after \"now\", found %s", t)
+	}
+
+	first := p.next()
+	if first.kind != pclTokenWord {
+		return nil, p.errorf(first.pos, "expected weekdays or a date, found %s", first)
+	}
+
+	if _, err := time.Parse("2006-01-02", first.text); err == nil {
+		return p.parseAbsoluteRange(first)
+	}
+
+	n := &pclNowIn{weekly: true}
+	for _, d := range strings.Split(first.text, ",") {
+		wd, ok := pclWeekdays[strings.ToLower(d)]
+		if !ok {
+			return nil, p.errorf(first.pos, "invalid weekday %q, expected one of Mon, Tue, Wed, Thu, Fri, Sat, Sun", d)
+		}
+		n.days[wd] = true
+	}
+
+	var err error
+	if n.start, err = p.parseTimeOfDay(); err != nil {
+		return nil, err
+	}
+	if t := p.next(); !t.is("to") {
+		return nil, p.errorf(t.pos, "expected \"to\", found %s", t)
+	}
+	if n.end, err = p.parseTimeOfDay(); err != nil {
+		return nil, err
+	}
+	if n.loc, err = p.parseLocation(); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (p *pclParser) parseTimeOfDay() (int, error) {
+	t := p.next()
+	tod, err := time.Parse("15:04:05", t.text)
+	if t.kind != pclTokenWord || err != nil {
+		return 0, p.errorf(t.pos, "expected a time as HH:MM:SS, found %s", t)
+	}
+	return tod.Hour()*3600 + tod.Minute()*60 + tod.Second(), nil
+}
+
+func (p *pclParser) parseLocation() (*time.Location, error) {
+	t := p.next()
+	if t.kind != pclTokenWord {
+		return nil, p.errorf(t.pos, "expected a time zone, found %s", t)
+	}
+	loc, err := time.LoadLocation(t.text)
+	if err != nil {
+		return nil, p.errorf(t.pos, "unknown time zone %q", t.text)
+	}
+	return loc, nil
+}
+
+func (p *pclParser) parseAbsoluteRange(fromDate pclToken) (pclNode, error) {
+	fromTime := p.next()
+	if t := p.next(); !t.is("to") {
+		return nil, p.errorf(t.pos, "expected \"to\", found %s", t)
+	}
+	toDate := p.next()
+	toTime := p.next()
+	loc, err := p.parseLocation()
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := time.ParseInLocation("2006-01-02 15:04:05", fromDate.text+" "+fromTime.text, loc)
+	if err != nil {
+		return nil, p.errorf(fromDate.pos, "expected a date and time as YYYY-MM-DD HH:MM:SS")
+	}
+	to, err := time.ParseInLocation("2006-01-02 15:04:05", toDate.text+" "+toTime.text, loc)
+	if err != nil {
+		return nil, p.errorf(toDate.pos, "expected a date and time as YYYY-MM-DD HH:MM:SS")
+	}
+	if !to.After(from) {
+		return nil, p.errorf(toDate.pos, "end of time range is not after its start")
+	}
+
+	return &pclNowIn{loc: loc, from: from, to: to}, nil
+}
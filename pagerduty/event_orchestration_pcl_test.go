@@ -0,0 +1,187 @@
+package pagerduty
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParsePCLSyntaxErrors(t *testing.T) {
+	cases := []struct {
+		expr   string
+		line   int
+		column int
+	}{
+		{`event.summary matches`, 1, 22},
+		{`event.summary matches 'disk`, 1, 23},
+		{`event.sumary matches 'disk'`, 1, 1},
+		{`evnt.summary exists`, 1, 1},
+		{`event.summary matches regex '(['`, 1, 29},
+		{`event.summary exists and`, 1, 25},
+		{`(event.summary exists`, 1, 22},
+		{`event.summary exists event.source exists`, 1, 22},
+		{"event.summary exists or\n  event.source equals 'x'", 2, 16},
+		{`now in Mon,Someday 09:00:00 to 17:00:00 UTC`, 1, 8},
+		{`now in Mon 09:00 to 17:00:00 UTC`, 1, 12},
+		{`now in Mon 09:00:00 to 17:00:00 Mars/Olympus`, 1, 33},
+		{`event.custom_details. exists`, 1, 1},
+	}
+
+	for _, c := range cases {
+		_, err := ParsePCL(c.expr)
+		var se *PCLSyntaxError
+		if !errors.As(err, &se) {
+			t.Errorf("ParsePCL(%q) returned %v; want a syntax error", c.expr, err)
+			continue
+		}
+		if se.Line != c.line || se.Column != c.column {
+			t.Errorf("ParsePCL(%q) error %q at %d:%d; want %d:%d", c.expr, se, se.Line, se.Column, c.line, c.column)
+		}
+	}
+}
+
+func TestPCLEvaluate(t *testing.T) {
+	ctx := &PCLContext{
+		Event: &OrchestrationEvent{
+			Summary:  "Disk full on db-1",
+			Source:   "db-1.prod",
+			Severity: "critical",
+			CustomDetails: map[string]interface{}{
+				"region":  "eu-west-1",
+				"disk":    map[string]interface{}{"used": float64(97)},
+				"tags":    []interface{}{"db", "prod"},
+				"on call": "sre",
+			},
+			RawEvent: map[string]interface{}{"routing_key": "R123"},
+		},
+		Variables:      map[string]interface{}{"team": "storage"},
+		CacheVariables: map[string]interface{}{"count": float64(3)},
+	}
+
+	cases := []struct {
+		expr string
+		want bool
+	}{
+		{`event.summary matches 'Disk full on db-1'`, true},
+		{`event.summary matches 'disk full on db-1'`, false},
+		{`event.summary matches part 'DISK FULL'`, true},
+		{`event.source matches regex '^db-\d+\.prod$'`, true},
+		{`event.custom_details.region matches 'eu-west-1'`, true},
+		{`event.custom_details.disk.used matches '97'`, true},
+		{`event.custom_details.tags.1 matches 'prod'`, true},
+		{`event.custom_details['on call'] matches 'sre'`, true},
+		{`event.custom_details.missing exists`, false},
+		{`event.component exists`, false},
+		{`raw_event.routing_key matches "R123"`, true},
+		{`variables.team matches 'storage'`, true},
+		{`cache_var.count matches '3'`, true},
+		{`not event.severity matches 'info'`, true},
+		{`event.severity matches 'info' or event.severity matches 'critical' and event.source exists`, true},
+		{`(event.severity matches 'info' or event.severity matches 'critical') and event.class exists`, false},
+		{`NOT (event.summary exists AND event.source exists)`, false},
+	}
+
+	for _, c := range cases {
+		expr, err := ParsePCL(c.expr)
+		if err != nil {
+			t.Errorf("ParsePCL(%q): %v", c.expr, err)
+			continue
+		}
+		got, err := expr.Evaluate(ctx)
+		if err != nil {
+			t.Errorf("Evaluate(%q): %v", c.expr, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("Evaluate(%q) = %v; want %v", c.expr, got, c.want)
+		}
+	}
+}
+
+func TestPCLEvaluateNowIn(t *testing.T) {
+	// 2024-01-03 was a Wednesday.
+	at := func(s string) *PCLContext {
+		now, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &PCLContext{Now: now}
+	}
+
+	cases := []struct {
+		expr string
+		ctx  *PCLContext
+		want bool
+	}{
+		{`now in Mon,Wed 09:00:00 to 17:00:00 UTC`, at("2024-01-03T10:00:00Z"), true},
+		{`now in Mon,Wed 09:00:00 to 17:00:00 UTC`, at("2024-01-03T17:00:00Z"), false},
+		{`now in Tue 09:00:00 to 17:00:00 UTC`, at("2024-01-03T10:00:00Z"), false},
+		{`now in Mon,Wed 09:00:00 to 17:00:00 America/New_York`, at("2024-01-03T10:00:00Z"), false},
+		{`now in Tue 22:00:00 to 06:00:00 UTC`, at("2024-01-03T05:00:00Z"), true},
+		{`now in Wed 22:00:00 to 06:00:00 UTC`, at("2024-01-03T05:00:00Z"), false},
+		{`now in 2024-01-01 00:00:00 to 2024-01-05 00:00:00 UTC`, at("2024-01-03T10:00:00Z"), true},
+		{`not now in 2024-01-01 00:00:00 to 2024-01-02 00:00:00 UTC`, at("2024-01-03T10:00:00Z"), true},
+	}
+
+	for _, c := range cases {
+		expr, err := ParsePCL(c.expr)
+		if err != nil {
+			t.Errorf("ParsePCL(%q): %v", c.expr, err)
+			continue
+		}
+		got, err := expr.Evaluate(c.ctx)
+		if err != nil {
+			t.Errorf("Evaluate(%q): %v", c.expr, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("Evaluate(%q) at %s = %v; want %v", c.expr, c.ctx.Now, got, c.want)
+		}
+	}
+}
+
+func TestPCLFieldPaths(t *testing.T) {
+	expr, err := ParsePCL(`variables.team exists and (event.summary matches 'x' or not variables.team matches 'y')`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"event.summary", "variables.team"}
+	if got := expr.FieldPaths(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FieldPaths() = %v; want %v", got, want)
+	}
+}
+
+func TestEvaluatePCLConditions(t *testing.T) {
+	ctx := &PCLContext{Event: &OrchestrationEvent{Severity: "warning"}}
+
+	ok, err := EvaluatePCLConditions(nil, ctx)
+	if err != nil || !ok {
+		t.Errorf("no conditions returned %v, %v; want true", ok, err)
+	}
+
+	ok, err = EvaluatePCLConditions([]*EventOrchestrationPathRuleCondition{
+		{Expression: `event.severity matches 'critical'`},
+		{Expression: `event.severity matches 'warning'`},
+	}, ctx)
+	if err != nil || !ok {
+		t.Errorf("conditions returned %v, %v; want true", ok, err)
+	}
+
+	if _, err := EvaluatePCLConditions([]*EventOrchestrationPathRuleCondition{{Expression: `event.severity is 'x'`}}, ctx); err == nil {
+		t.Error("expected a syntax error")
+	}
+}
+
+func TestParsePCLStringEscapes(t *testing.T) {
+	ctx := &PCLContext{Event: &OrchestrationEvent{Summary: `it's C:\temp`}}
+
+	expr, err := ParsePCL(`event.summary matches 'it\'s C:\temp'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := expr.Evaluate(ctx); !ok {
+		t.Errorf("%s did not match %q", expr, ctx.Event.Summary)
+	}
+}
@@ -0,0 +1,575 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RulesetConversion holds the Event Orchestration paths converted from
+// ruleset and service event rules, along with what could not be expressed.
+type RulesetConversion struct {
+	Global *EventOrchestrationPath
+	Router *EventOrchestrationPath
+
+	// Services holds the service paths converted from service event rules,
+	// by service ID.
+	Services map[string]*EventOrchestrationPath
+
+	Issues []*RulesetConversionIssue
+}
+
+// RulesetConversionIssue describes part of a rule that was dropped or
+// changed during conversion. ServiceID is set for service event rules.
+type RulesetConversionIssue struct {
+	ServiceID string
+	RuleID    string
+	Message   string
+}
+
+func (i *RulesetConversionIssue) String() string {
+	if i.ServiceID != "" {
+		return fmt.Sprintf("service %s rule %s: %s", i.ServiceID, i.RuleID, i.Message)
+	}
+	return fmt.Sprintf("rule %s: %s", i.RuleID, i.Message)
+}
+
+// rulesetRuleSource is what ruleset rules and service event rules have in
+// common.
+type rulesetRuleSource struct {
+	ID         string
+	Position   *int
+	Disabled   bool
+	CatchAll   bool
+	Conditions *RuleConditions
+	TimeFrame  *RuleTimeFrame
+	Variables  []*RuleVariable
+	Actions    *RuleActions
+}
+
+// ConvertRulesets converts the rules of a global ruleset into a global and a
+// router path, and the event rules of services into service paths.
+//
+// A ruleset applies the actions of the first rule that matches, including
+// its route. To keep that behaviour every rule appears in both the global
+// and the router path with the same conditions: the global rule carries the
+// actions and the router rule routes to the rule's service, or to
+// "unrouted" when the rule has no route action. The router sees the event
+// as changed by the global path, so rules whose extractions or variables
+// write fields read by router conditions are reported as issues.
+func ConvertRulesets(rules []*RulesetRule, serviceRules map[string][]*ServiceEventRule) *RulesetConversion {
+	c := &RulesetConversion{Services: make(map[string]*EventOrchestrationPath)}
+
+	if rules != nil {
+		var sources []*rulesetRuleSource
+		for _, r := range rules {
+			sources = append(sources, &rulesetRuleSource{
+				ID:         r.ID,
+				Position:   r.Position,
+				Disabled:   r.Disabled,
+				CatchAll:   r.CatchAll,
+				Conditions: r.Conditions,
+				TimeFrame:  r.TimeFrame,
+				Variables:  r.Variables,
+				Actions:    r.Actions,
+			})
+		}
+		c.Global, c.Router = c.convertGlobal(sources)
+	}
+
+	serviceIDs := make([]string, 0, len(serviceRules))
+	for id := range serviceRules {
+		serviceIDs = append(serviceIDs, id)
+	}
+	sort.Strings(serviceIDs)
+
+	for _, id := range serviceIDs {
+		var sources []*rulesetRuleSource
+		for _, r := range serviceRules[id] {
+			sources = append(sources, &rulesetRuleSource{
+				ID:         r.ID,
+				Position:   r.Position,
+				Disabled:   r.Disabled,
+				Conditions: r.Conditions,
+				TimeFrame:  r.TimeFrame,
+				Variables:  r.Variables,
+				Actions:    r.Actions,
+			})
+		}
+		c.Services[id] = c.convertService(id, sources)
+	}
+
+	return c
+}
+
+func (c *RulesetConversion) issuef(serviceID, ruleID, format string, args ...interface{}) {
+	c.Issues = append(c.Issues, &RulesetConversionIssue{
+		ServiceID: serviceID,
+		RuleID:    ruleID,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+// sortRulesetRules orders rules by position, keeping rules without one in
+// their given order after those with one.
+func sortRulesetRules(rules []*rulesetRuleSource) []*rulesetRuleSource {
+	sorted := append([]*rulesetRuleSource(nil), rules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].Position, sorted[j].Position
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a < *b
+	})
+	return sorted
+}
+
+func (c *RulesetConversion) convertGlobal(rules []*rulesetRuleSource) (*EventOrchestrationPath, *EventOrchestrationPath) {
+	global := &EventOrchestrationPath{
+		Type:     PathTypeGlobal,
+		Sets:     []*EventOrchestrationPathSet{{ID: "start", Rules: []*EventOrchestrationPathRule{}}},
+		CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{}},
+	}
+	router := &EventOrchestrationPath{
+		Type: PathTypeRouter,
+		Sets: []*EventOrchestrationPathSet{{ID: "start", Rules: []*EventOrchestrationPathRule{}}},
+		CatchAll: &EventOrchestrationPathCatchAll{
+			Actions: &EventOrchestrationPathRuleActions{RouteTo: "unrouted"},
+		},
+	}
+
+	// ids holds the rule IDs of the global rules, then of the catch-all.
+	var ids []string
+	catchAllID := ""
+	for _, r := range sortRulesetRules(rules) {
+		actions := c.convertActions("", r)
+		routeTo := "unrouted"
+		if r.Actions != nil && r.Actions.Route != nil && r.Actions.Route.Value != "" {
+			routeTo = r.Actions.Route.Value
+		}
+
+		if r.CatchAll {
+			catchAllID = r.ID
+			global.CatchAll = &EventOrchestrationPathCatchAll{Actions: actions}
+			router.CatchAll = &EventOrchestrationPathCatchAll{
+				Actions: &EventOrchestrationPathRuleActions{RouteTo: routeTo},
+			}
+			continue
+		}
+
+		conditions, ok := c.convertConditions("", r)
+		label := fmt.Sprintf("Ruleset rule %s", r.ID)
+		global.Sets[0].Rules = append(global.Sets[0].Rules, &EventOrchestrationPathRule{
+			Label:      label,
+			Conditions: conditions,
+			Actions:    actions,
+			Disabled:   r.Disabled || !ok,
+		})
+		router.Sets[0].Rules = append(router.Sets[0].Rules, &EventOrchestrationPathRule{
+			Label:      label,
+			Conditions: conditions,
+			Actions:    &EventOrchestrationPathRuleActions{RouteTo: routeTo},
+			Disabled:   r.Disabled || !ok,
+		})
+		ids = append(ids, r.ID)
+	}
+
+	c.checkRouterReads(global, router, append(ids, catchAllID))
+	return global, router
+}
+
+// checkRouterReads reports the global rules whose extractions or variables
+// write fields read by router conditions. The router evaluates events after
+// the global path applied the actions of the matching rule, whereas a
+// ruleset stops at the first matching rule, so such events may be routed
+// differently. ids holds the rule IDs of the global rules, then of the
+// catch-all.
+func (c *RulesetConversion) checkRouterReads(global, router *EventOrchestrationPath, ids []string) {
+	reads := make(map[string][]string)
+	for i, r := range router.Sets[0].Rules {
+		if r.Disabled {
+			continue
+		}
+		for _, cond := range r.Conditions {
+			expr, err := ParsePCL(cond.Expression)
+			if err != nil {
+				continue
+			}
+			for _, p := range expr.FieldPaths() {
+				reads[p] = append(reads[p], ids[i])
+			}
+		}
+	}
+
+	check := func(ruleID string, actions *EventOrchestrationPathRuleActions) {
+		if actions == nil {
+			return
+		}
+		var writes []string
+		for _, v := range actions.Variables {
+			writes = append(writes, "variables."+v.Name)
+		}
+		for _, e := range actions.Extractions {
+			writes = append(writes, e.Target)
+		}
+
+		fields := make(map[string]bool)
+		readers := make(map[string]bool)
+		for _, w := range writes {
+			for p, rules := range reads {
+				if p != w && !strings.HasPrefix(p, w+".") && !strings.HasPrefix(w, p+".") {
+					continue
+				}
+				fields[w] = true
+				for _, id := range rules {
+					readers[id] = true
+				}
+			}
+		}
+		if len(fields) == 0 {
+			return
+		}
+
+		c.issuef("", ruleID, "the rule writes %s, read by the router conditions of rules %s; events may be routed differently than by the ruleset",
+			strings.Join(sortedKeys(fields), ", "), strings.Join(sortedKeys(readers), ", "))
+	}
+
+	for i, r := range global.Sets[0].Rules {
+		if !r.Disabled {
+			check(ids[i], r.Actions)
+		}
+	}
+	if global.CatchAll != nil {
+		check(ids[len(ids)-1], global.CatchAll.Actions)
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (c *RulesetConversion) convertService(serviceID string, rules []*rulesetRuleSource) *EventOrchestrationPath {
+	path := &EventOrchestrationPath{
+		Type:     PathTypeService,
+		Parent:   &EventOrchestrationPathReference{ID: serviceID, Type: "service_reference"},
+		Sets:     []*EventOrchestrationPathSet{{ID: "start", Rules: []*EventOrchestrationPathRule{}}},
+		CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{}},
+	}
+
+	for _, r := range sortRulesetRules(rules) {
+		if r.Actions != nil && r.Actions.Route != nil && r.Actions.Route.Value != "" {
+			c.issuef(serviceID, r.ID, "route action to %s is not supported on a service path and was dropped", r.Actions.Route.Value)
+		}
+		conditions, ok := c.convertConditions(serviceID, r)
+		path.Sets[0].Rules = append(path.Sets[0].Rules, &EventOrchestrationPathRule{
+			Label:      fmt.Sprintf("Event rule %s", r.ID),
+			Conditions: conditions,
+			Actions:    c.convertActions(serviceID, r),
+			Disabled:   r.Disabled || !ok,
+		})
+	}
+
+	return path
+}
+
+// convertConditions converts the conditions and time frame of a rule. The
+// orchestration conditions of a rule match if any of them matches, so "or"
+// subconditions each become a condition and "and" subconditions are joined
+// into one. The time frame is added to every condition. It reports false if
+// any part could not be converted, in which case the converted rule would
+// match more events than the original and must be disabled.
+func (c *RulesetConversion) convertConditions(serviceID string, r *rulesetRuleSource) ([]*EventOrchestrationPathRuleCondition, bool) {
+	ok := true
+	var exprs []string
+	if r.Conditions != nil {
+		for _, sc := range r.Conditions.RuleSubconditions {
+			expr, err := rulesetSubconditionPCL(sc)
+			if err != nil {
+				c.issuef(serviceID, r.ID, "%v; the rule was disabled", err)
+				ok = false
+				continue
+			}
+			exprs = append(exprs, expr)
+		}
+		if len(exprs) > 1 && !strings.EqualFold(r.Conditions.Operator, "or") {
+			exprs = []string{strings.Join(exprs, " and ")}
+		}
+	}
+
+	if r.TimeFrame != nil {
+		timeExpr, err := rulesetTimeFramePCL(r.TimeFrame)
+		if err != nil {
+			c.issuef(serviceID, r.ID, "%v; the rule was disabled", err)
+			ok = false
+		} else if len(exprs) == 0 {
+			exprs = []string{timeExpr}
+		} else {
+			for i, e := range exprs {
+				exprs[i] = e + " and " + timeExpr
+			}
+		}
+	}
+
+	conditions := []*EventOrchestrationPathRuleCondition{}
+	for _, e := range exprs {
+		if _, err := ParsePCL(e); err != nil {
+			c.issuef(serviceID, r.ID, "converted condition %q is invalid: %v; the rule was disabled", e, err)
+			ok = false
+			continue
+		}
+		conditions = append(conditions, &EventOrchestrationPathRuleCondition{Expression: e})
+	}
+	return conditions, ok
+}
+
+func rulesetSubconditionPCL(sc *RuleSubcondition) (string, error) {
+	if sc.Parameters == nil {
+		return "", fmt.Errorf("subcondition %q has no parameters", sc.Operator)
+	}
+	path, err := rulesetPathPCL(sc.Parameters.Path)
+	if err != nil {
+		return "", err
+	}
+	value, err := pclQuote(sc.Parameters.Value)
+	if err != nil && sc.Operator != "exists" && sc.Operator != "nexists" {
+		return "", err
+	}
+
+	switch sc.Operator {
+	case "exists":
+		return path + " exists", nil
+	case "nexists":
+		return "not " + path + " exists", nil
+	case "equals":
+		return path + " matches " + value, nil
+	case "nequals":
+		return "not " + path + " matches " + value, nil
+	case "contains":
+		return path + " matches part " + value, nil
+	case "ncontains":
+		return "not " + path + " matches part " + value, nil
+	case "matches":
+		return path + " matches regex " + value, nil
+	case "nmatches":
+		return "not " + path + " matches regex " + value, nil
+	}
+	return "", fmt.Errorf("unsupported condition operator %q", sc.Operator)
+}
+
+var pclIdentifier = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// rulesetPathPCL converts the path of a PD-CEF event field used by rulesets,
+// such as payload.summary or payload.custom_details.host, to the matching
+// PCL field. Other paths match the raw event.
+func rulesetPathPCL(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("empty field path")
+	}
+	segs := strings.Split(path, ".")
+
+	var out []string
+	switch {
+	case segs[0] == "payload" && len(segs) > 1 && pclEventFields[segs[1]]:
+		if segs[1] != "custom_details" && len(segs) > 2 {
+			return "", fmt.Errorf("field %q has no sub-fields", path)
+		}
+		out = append([]string{"event"}, segs[1:]...)
+	case (segs[0] == "event_action" || segs[0] == "dedup_key") && len(segs) == 1:
+		out = []string{"event", segs[0]}
+	default:
+		out = append([]string{"raw_event"}, segs...)
+	}
+
+	var b strings.Builder
+	for i, seg := range out {
+		switch {
+		case seg == "":
+			return "", fmt.Errorf("empty segment in field path %q", path)
+		case pclIdentifier.MatchString(seg):
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(seg)
+		default:
+			q, err := pclQuote(seg)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString("[" + q + "]")
+		}
+	}
+	return b.String(), nil
+}
+
+// pclQuote quotes s as a PCL string. A trailing backslash cannot be
+// expressed since it would escape the closing quote.
+func pclQuote(s string) (string, error) {
+	if strings.HasSuffix(s, `\`) {
+		return "", fmt.Errorf("value %q ends with a backslash, which PCL cannot express", s)
+	}
+	return "'" + strings.ReplaceAll(s, "'", `\'`) + "'", nil
+}
+
+var rulesetWeekdays = map[int]string{
+	1: "Mon",
+	2: "Tue",
+	3: "Wed",
+	4: "Thu",
+	5: "Fri",
+	6: "Sat",
+	7: "Sun",
+}
+
+// rulesetTimeFramePCL converts a time frame to a "now in" condition. Weekly
+// schedules must last less than a day since PCL ranges end on the day after
+// they start at the latest.
+func rulesetTimeFramePCL(tf *RuleTimeFrame) (string, error) {
+	if tf.ScheduledWeekly != nil && tf.ActiveBetween != nil {
+		return "", fmt.Errorf("time frame has both a weekly schedule and an active range")
+	}
+
+	if w := tf.ScheduledWeekly; w != nil {
+		const day = 24 * 60 * 60 * 1000
+		if w.Duration <= 0 || w.Duration >= day {
+			return "", fmt.Errorf("weekly schedule lasting %s cannot be expressed", time.Duration(w.Duration)*time.Millisecond)
+		}
+		if w.StartTime%1000 != 0 || w.Duration%1000 != 0 {
+			return "", fmt.Errorf("weekly schedule has sub-second precision")
+		}
+		if len(w.Weekdays) == 0 {
+			return "", fmt.Errorf("weekly schedule has no weekdays")
+		}
+
+		days := make([]string, 0, len(w.Weekdays))
+		for _, d := range w.Weekdays {
+			name, ok := rulesetWeekdays[d]
+			if !ok {
+				return "", fmt.Errorf("invalid weekday %d", d)
+			}
+			days = append(days, name)
+		}
+
+		tz := w.Timezone
+		if tz == "" {
+			tz = "UTC"
+		}
+		if _, err := time.LoadLocation(tz); err != nil {
+			return "", fmt.Errorf("unknown time zone %q", tz)
+		}
+
+		start := w.StartTime % day / 1000
+		end := (w.StartTime + w.Duration) % day / 1000
+		return fmt.Sprintf("now in %s %s to %s %s", strings.Join(days, ","), pclTimeOfDay(start), pclTimeOfDay(end), tz), nil
+	}
+
+	if a := tf.ActiveBetween; a != nil {
+		if a.EndTime <= a.StartTime {
+			return "", fmt.Errorf("active range ends before it starts")
+		}
+		const layout = "2006-01-02 15:04:05"
+		from := time.Unix(0, int64(a.StartTime)*int64(time.Millisecond)).UTC()
+		to := time.Unix(0, int64(a.EndTime)*int64(time.Millisecond)).UTC()
+		return fmt.Sprintf("now in %s to %s UTC", from.Format(layout), to.Format(layout)), nil
+	}
+
+	return "", fmt.Errorf("time frame is empty")
+}
+
+func pclTimeOfDay(secs int) string {
+	return fmt.Sprintf("%02d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+}
+
+func (c *RulesetConversion) convertActions(serviceID string, r *rulesetRuleSource) *EventOrchestrationPathRuleActions {
+	actions := &EventOrchestrationPathRuleActions{}
+
+	for _, v := range r.Variables {
+		if v.Parameters == nil {
+			c.issuef(serviceID, r.ID, "variable %s has no parameters and was dropped", v.Name)
+			continue
+		}
+		if v.Type != "regex" {
+			c.issuef(serviceID, r.ID, "variable %s has unsupported type %q and was dropped", v.Name, v.Type)
+			continue
+		}
+		path, err := rulesetPathPCL(v.Parameters.Path)
+		if err != nil {
+			c.issuef(serviceID, r.ID, "variable %s: %v; it was dropped", v.Name, err)
+			continue
+		}
+		actions.Variables = append(actions.Variables, &EventOrchestrationPathActionVariables{
+			Name:  v.Name,
+			Path:  path,
+			Type:  v.Type,
+			Value: v.Parameters.Value,
+		})
+	}
+
+	a := r.Actions
+	if a == nil {
+		return actions
+	}
+
+	if a.Suppress != nil && a.Suppress.Value {
+		actions.Suppress = true
+		if a.Suppress.ThresholdValue != 0 || a.Suppress.ThresholdTimeAmount != 0 {
+			c.issuef(serviceID, r.ID, "suppression thresholds are not supported; events are always suppressed")
+		}
+	}
+	if a.Suspend != nil {
+		suspend := a.Suspend.Value
+		actions.Suspend = &suspend
+	}
+	if a.Annotate != nil {
+		actions.Annotate = a.Annotate.Value
+	}
+	if a.Severity != nil {
+		actions.Severity = a.Severity.Value
+	}
+	if a.Priority != nil {
+		actions.Priority = a.Priority.Value
+	}
+	if a.EventAction != nil {
+		actions.EventAction = a.EventAction.Value
+	}
+
+	for _, e := range a.Extractions {
+		target, err := rulesetExtractionTarget(e.Target)
+		if err != nil {
+			c.issuef(serviceID, r.ID, "%v; the extraction was dropped", err)
+			continue
+		}
+		ext := &EventOrchestrationPathActionExtractions{Target: target}
+		if e.Template != "" {
+			ext.Template = orchestrationTemplateVar.ReplaceAllString(e.Template, "{{variables.$1}}")
+		} else {
+			source, err := rulesetPathPCL(e.Source)
+			if err != nil {
+				c.issuef(serviceID, r.ID, "extraction to %s: %v; it was dropped", e.Target, err)
+				continue
+			}
+			ext.Source = source
+			ext.Regex = e.Regex
+		}
+		actions.Extractions = append(actions.Extractions, ext)
+	}
+
+	return actions
+}
+
+// rulesetExtractionTarget converts an extraction target, such as summary or
+// payload.custom_details.host, to an event field.
+func rulesetExtractionTarget(target string) (string, error) {
+	t := strings.TrimPrefix(target, "payload.")
+	field := strings.SplitN(t, ".", 2)[0]
+	if !pclEventFields[field] || (field != "custom_details" && t != field) || t == "custom_details" {
+		return "", fmt.Errorf("unsupported extraction target %q", target)
+	}
+	return "event." + t, nil
+}
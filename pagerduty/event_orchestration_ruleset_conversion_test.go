@@ -0,0 +1,218 @@
+package pagerduty
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConvertRulesets(t *testing.T) {
+	pos0, pos1 := 0, 1
+	rules := []*RulesetRule{
+		{
+			ID:       "R2",
+			Position: &pos1,
+			Conditions: &RuleConditions{
+				Operator: "or",
+				RuleSubconditions: []*RuleSubcondition{
+					{Operator: "contains", Parameters: &ConditionParameter{Path: "payload.summary", Value: "disk"}},
+					{Operator: "nequals", Parameters: &ConditionParameter{Path: "payload.custom_details.team name", Value: "o'brien"}},
+				},
+			},
+			TimeFrame: &RuleTimeFrame{ScheduledWeekly: &ScheduledWeekly{
+				Weekdays:  []int{1, 7},
+				Timezone:  "Europe/Paris",
+				StartTime: 22 * 60 * 60 * 1000,
+				Duration:  4 * 60 * 60 * 1000,
+			}},
+			Actions: &RuleActions{
+				Route:    &RuleActionParameter{Value: "PSERVICE"},
+				Severity: &RuleActionParameter{Value: "critical"},
+				Suppress: &RuleActionSuppress{Value: true, ThresholdValue: 2, ThresholdTimeUnit: "minutes", ThresholdTimeAmount: 5},
+			},
+		},
+		{
+			ID:       "R1",
+			Position: &pos0,
+			Conditions: &RuleConditions{
+				Operator: "and",
+				RuleSubconditions: []*RuleSubcondition{
+					{Operator: "matches", Parameters: &ConditionParameter{Path: "payload.source", Value: `^db-\d+`}},
+					{Operator: "exists", Parameters: &ConditionParameter{Path: "routing_key"}},
+				},
+			},
+			Variables: []*RuleVariable{
+				{Name: "host", Type: "regex", Parameters: &RuleVariableParameter{Path: "payload.source", Value: `(.*)\.prod`}},
+			},
+			Actions: &RuleActions{
+				Annotate: &RuleActionParameter{Value: "database"},
+				Suspend:  &RuleActionIntParameter{Value: 60},
+				Extractions: []*RuleActionExtraction{
+					{Target: "summary", Template: "{{host}} is down"},
+					{Target: "dedup_key", Source: "payload.source", Regex: "(.*)"},
+				},
+			},
+		},
+		{
+			ID:       "CA",
+			CatchAll: true,
+			Actions:  &RuleActions{Suppress: &RuleActionSuppress{Value: true}},
+		},
+	}
+
+	c := ConvertRulesets(rules, nil)
+
+	suspend := 60
+	wantGlobal := &EventOrchestrationPath{
+		Type: PathTypeGlobal,
+		Sets: []*EventOrchestrationPathSet{{ID: "start", Rules: []*EventOrchestrationPathRule{
+			{
+				Label:      "Ruleset rule R1",
+				Conditions: []*EventOrchestrationPathRuleCondition{{Expression: `event.source matches regex '^db-\d+' and raw_event.routing_key exists`}},
+				Actions: &EventOrchestrationPathRuleActions{
+					Annotate: "database",
+					Suspend:  &suspend,
+					Variables: []*EventOrchestrationPathActionVariables{
+						{Name: "host", Path: "event.source", Type: "regex", Value: `(.*)\.prod`},
+					},
+					Extractions: []*EventOrchestrationPathActionExtractions{
+						{Target: "event.summary", Template: "{{variables.host}} is down"},
+						{Target: "event.dedup_key", Source: "event.source", Regex: "(.*)"},
+					},
+				},
+			},
+			{
+				Label: "Ruleset rule R2",
+				Conditions: []*EventOrchestrationPathRuleCondition{
+					{Expression: "event.summary matches part 'disk' and now in Mon,Sun 22:00:00 to 02:00:00 Europe/Paris"},
+					{Expression: `not event.custom_details['team name'] matches 'o\'brien' and now in Mon,Sun 22:00:00 to 02:00:00 Europe/Paris`},
+				},
+				Actions: &EventOrchestrationPathRuleActions{Severity: "critical", Suppress: true},
+			},
+		}}},
+		CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{Suppress: true}},
+	}
+	if !reflect.DeepEqual(c.Global, wantGlobal) {
+		t.Errorf("global path %+v; want %+v", c.Global, wantGlobal)
+	}
+
+	var routes []string
+	for _, r := range c.Router.Sets[0].Rules {
+		routes = append(routes, r.Label+" -> "+r.Actions.RouteTo)
+	}
+	wantRoutes := []string{"Ruleset rule R1 -> unrouted", "Ruleset rule R2 -> PSERVICE"}
+	if !reflect.DeepEqual(routes, wantRoutes) {
+		t.Errorf("router rules %q; want %q", routes, wantRoutes)
+	}
+	if c.Router.CatchAll.Actions.RouteTo != "unrouted" {
+		t.Errorf("router catch-all routes to %q", c.Router.CatchAll.Actions.RouteTo)
+	}
+
+	if len(c.Issues) != 2 || c.Issues[0].RuleID != "R2" || !strings.Contains(c.Issues[0].Message, "threshold") {
+		t.Errorf("unexpected issues %v", c.Issues)
+	}
+	// R1 rewrites the summary that the router condition of R2 reads.
+	if want := "rule R1: the rule writes event.summary, read by the router conditions of rules R2; events may be routed differently than by the ruleset"; len(c.Issues) == 2 && c.Issues[1].String() != want {
+		t.Errorf("issue %q; want %q", c.Issues[1], want)
+	}
+}
+
+func TestConvertRulesetsSimulate(t *testing.T) {
+	rules := []*RulesetRule{
+		{
+			ID: "R1",
+			Conditions: &RuleConditions{Operator: "and", RuleSubconditions: []*RuleSubcondition{
+				{Operator: "contains", Parameters: &ConditionParameter{Path: "payload.summary", Value: "test"}},
+			}},
+			Actions: &RuleActions{Severity: &RuleActionParameter{Value: "info"}},
+		},
+		{
+			ID: "R2",
+			Conditions: &RuleConditions{Operator: "and", RuleSubconditions: []*RuleSubcondition{
+				{Operator: "equals", Parameters: &ConditionParameter{Path: "payload.severity", Value: "critical"}},
+			}},
+			Actions: &RuleActions{Route: &RuleActionParameter{Value: "PDB"}},
+		},
+	}
+	c := ConvertRulesets(rules, nil)
+	s := &OrchestrationSimulator{Global: c.Global, Router: c.Router}
+
+	// R1 matches first, so the event is not routed by R2 even though it
+	// matches it too.
+	res, err := s.Simulate(&OrchestrationEvent{Summary: "test alert", Severity: "critical"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Unrouted || res.Event.Severity != "info" {
+		t.Errorf("unexpected result %+v", res)
+	}
+
+	res, err = s.Simulate(&OrchestrationEvent{Summary: "alert", Severity: "critical"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ServiceID != "PDB" {
+		t.Errorf("routed to %q; want PDB", res.ServiceID)
+	}
+}
+
+func TestConvertServiceEventRules(t *testing.T) {
+	c := ConvertRulesets(nil, map[string][]*ServiceEventRule{
+		"PSERVICE": {
+			{
+				ID: "E1",
+				Conditions: &RuleConditions{Operator: "and", RuleSubconditions: []*RuleSubcondition{
+					{Operator: "exists", Parameters: &ConditionParameter{Path: "payload.class"}},
+					{Operator: "unknown", Parameters: &ConditionParameter{Path: "payload.summary", Value: "x"}},
+				}},
+				TimeFrame: &RuleTimeFrame{ActiveBetween: &ActiveBetween{StartTime: 1704067200000, EndTime: 1704153600000}},
+				Actions:   &RuleActions{Route: &RuleActionParameter{Value: "POTHER"}, Priority: &RuleActionParameter{Value: "P1"}},
+			},
+		},
+	})
+
+	if c.Global != nil || c.Router != nil {
+		t.Errorf("unexpected global or router path")
+	}
+	path := c.Services["PSERVICE"]
+	if path == nil || path.Type != PathTypeService || path.Parent.ID != "PSERVICE" {
+		t.Fatalf("unexpected service path %+v", path)
+	}
+	rule := path.Sets[0].Rules[0]
+	if !rule.Disabled {
+		t.Errorf("rule with an unconvertible condition should be disabled")
+	}
+	want := "event.class exists and now in 2024-01-01 00:00:00 to 2024-01-02 00:00:00 UTC"
+	if len(rule.Conditions) != 1 || rule.Conditions[0].Expression != want {
+		t.Errorf("conditions %+v; want %q", rule.Conditions, want)
+	}
+	if rule.Actions.Priority != "P1" || rule.Actions.RouteTo != "" {
+		t.Errorf("unexpected actions %+v", rule.Actions)
+	}
+	if len(c.Issues) != 2 {
+		t.Errorf("issues %v; want 2", c.Issues)
+	}
+}
+
+func TestRulesetTimeFramePCL(t *testing.T) {
+	cases := []struct {
+		tf   *RuleTimeFrame
+		want string
+		err  bool
+	}{
+		{tf: &RuleTimeFrame{ScheduledWeekly: &ScheduledWeekly{Weekdays: []int{2, 3}, StartTime: 9 * 3600000, Duration: 8 * 3600000}}, want: "now in Tue,Wed 09:00:00 to 17:00:00 UTC"},
+		{tf: &RuleTimeFrame{ScheduledWeekly: &ScheduledWeekly{Weekdays: []int{1}, Duration: 24 * 3600000}}, err: true},
+		{tf: &RuleTimeFrame{ScheduledWeekly: &ScheduledWeekly{Weekdays: []int{8}, Duration: 3600000}}, err: true},
+		{tf: &RuleTimeFrame{ScheduledWeekly: &ScheduledWeekly{Weekdays: []int{1}, Duration: 3600000, Timezone: "Nowhere/Land"}}, err: true},
+		{tf: &RuleTimeFrame{ActiveBetween: &ActiveBetween{StartTime: 2000, EndTime: 1000}}, err: true},
+		{tf: &RuleTimeFrame{}, err: true},
+	}
+
+	for _, c := range cases {
+		got, err := rulesetTimeFramePCL(c.tf)
+		if (err != nil) != c.err || got != c.want {
+			t.Errorf("rulesetTimeFramePCL(%+v) = %q, %v", c.tf, got, err)
+		}
+	}
+
+}
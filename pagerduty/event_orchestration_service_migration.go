@@ -0,0 +1,175 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// ServiceOrchestrationMigration moves a service from its legacy event rules
+// to a service orchestration. It is created by PlanServiceMigrationContext,
+// which builds Path from the event rules without changing anything, and
+// carried out by ApplyServiceMigrationContext.
+type ServiceOrchestrationMigration struct {
+	ServiceID string
+
+	// Rules are the event rules of the service and Path the service
+	// orchestration converted from them. Issues lists what the conversion
+	// could not express.
+	Rules  []*ServiceEventRule
+	Path   *EventOrchestrationPath
+	Issues []*RulesetConversionIssue
+
+	// AcceptIssues lets ApplyServiceMigrationContext apply a path whose
+	// conversion had issues, such as disabled rules or dropped actions.
+	AcceptIssues bool
+
+	// Current is the service orchestration before the migration and
+	// WasActive whether it was already active.
+	Current   *EventOrchestrationPath
+	WasActive bool
+
+	// Diff describes how Path differs from Current.
+	Diff *EventOrchestrationPathDiff
+
+	// Warnings are returned by PagerDuty when Path is applied.
+	Warnings []*EventOrchestrationPathWarning
+}
+
+// ServiceMigrationVerifier checks a service after its orchestration was
+// applied and activated. Returning an error rolls the migration back.
+type ServiceMigrationVerifier func(ctx context.Context, m *ServiceOrchestrationMigration) error
+
+// PlanServiceMigrationContext builds the service orchestration for the event
+// rules of a service and compares it to the current one.
+func (s *EventOrchestrationPathService) PlanServiceMigrationContext(ctx context.Context, serviceID string) (*ServiceOrchestrationMigration, error) {
+	rules, err := s.client.Services.ListAllEventRulesContext(ctx, serviceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	current, _, err := s.GetContext(ctx, serviceID, PathTypeService)
+	if err != nil {
+		return nil, err
+	}
+
+	status, _, err := s.GetServiceActiveStatusContext(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	c := ConvertRulesets(nil, map[string][]*ServiceEventRule{serviceID: rules})
+	m := &ServiceOrchestrationMigration{
+		ServiceID: serviceID,
+		Rules:     rules,
+		Path:      c.Services[serviceID],
+		Issues:    c.Issues,
+		Current:   current,
+		WasActive: status.Active,
+	}
+	m.Diff = DiffEventOrchestrationPaths(current, m.Path)
+
+	return m, nil
+}
+
+// ApplyServiceMigrationContext updates the service orchestration to the
+// planned path and makes it active. It refuses to when the conversion had
+// issues, unless AcceptIssues is set. If verify is nil, the service is checked
+// to have the planned rules and an active orchestration. When verification
+// fails the migration is rolled back and the verification error returned.
+func (s *EventOrchestrationPathService) ApplyServiceMigrationContext(ctx context.Context, m *ServiceOrchestrationMigration, verify ServiceMigrationVerifier) error {
+	if m.Path == nil {
+		return fmt.Errorf("migration of service %s has no planned path", m.ServiceID)
+	}
+	if len(m.Issues) > 0 && !m.AcceptIssues {
+		return fmt.Errorf("conversion of service %s had %d issues, starting with %s; set AcceptIssues to apply it anyway", m.ServiceID, len(m.Issues), m.Issues[0])
+	}
+
+	payload, _, err := s.UpdateContext(ctx, m.ServiceID, PathTypeService, m.Path)
+	if err != nil {
+		return err
+	}
+	m.Warnings = payload.Warnings
+
+	if _, _, err := s.UpdateServiceActiveStatusContext(ctx, m.ServiceID, true); err != nil {
+		if rerr := s.RollbackServiceMigrationContext(ctx, m); rerr != nil {
+			return fmt.Errorf("%v; rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+
+	if verify == nil {
+		verify = s.verifyServiceMigration
+	}
+	if err := verify(ctx, m); err != nil {
+		if rerr := s.RollbackServiceMigrationContext(ctx, m); rerr != nil {
+			return fmt.Errorf("verification of service %s failed: %v; rollback failed: %v", m.ServiceID, err, rerr)
+		}
+		return fmt.Errorf("verification of service %s failed and the migration was rolled back: %v", m.ServiceID, err)
+	}
+
+	return nil
+}
+
+// RollbackServiceMigrationContext restores the active status the service
+// orchestration had before the migration, then its previous rules.
+func (s *EventOrchestrationPathService) RollbackServiceMigrationContext(ctx context.Context, m *ServiceOrchestrationMigration) error {
+	if _, _, err := s.UpdateServiceActiveStatusContext(ctx, m.ServiceID, m.WasActive); err != nil {
+		return err
+	}
+
+	if m.Current != nil {
+		previous := &EventOrchestrationPath{
+			Type:     m.Current.Type,
+			Parent:   m.Current.Parent,
+			Sets:     m.Current.Sets,
+			CatchAll: m.Current.CatchAll,
+		}
+		if _, _, err := s.UpdateContext(ctx, m.ServiceID, PathTypeService, previous); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *EventOrchestrationPathService) verifyServiceMigration(ctx context.Context, m *ServiceOrchestrationMigration) error {
+	status, _, err := s.GetServiceActiveStatusContext(ctx, m.ServiceID)
+	if err != nil {
+		return err
+	}
+	if !status.Active {
+		return fmt.Errorf("service orchestration is not active")
+	}
+
+	path, _, err := s.GetContext(ctx, m.ServiceID, PathTypeService)
+	if err != nil {
+		return err
+	}
+	if d := DiffEventOrchestrationPaths(withPositionalRuleIDs(m.Path), withPositionalRuleIDs(path)); !d.Empty() {
+		return fmt.Errorf("service orchestration differs from the planned one:\n%s", d)
+	}
+
+	return nil
+}
+
+// withPositionalRuleIDs returns a copy of a path whose rules are identified
+// by their position in their set, so that paths can be compared regardless
+// of the IDs PagerDuty assigns to rules.
+func withPositionalRuleIDs(path *EventOrchestrationPath) *EventOrchestrationPath {
+	if path == nil {
+		return nil
+	}
+	c := *path
+	c.Sets = make([]*EventOrchestrationPathSet, 0, len(path.Sets))
+	for _, set := range path.Sets {
+		sc := *set
+		sc.Rules = make([]*EventOrchestrationPathRule, 0, len(set.Rules))
+		for i, r := range set.Rules {
+			rc := *r
+			rc.ID = fmt.Sprintf("%s/%d", set.ID, i)
+			sc.Rules = append(sc.Rules, &rc)
+		}
+		c.Sets = append(c.Sets, &sc)
+	}
+	return &c
+}
@@ -0,0 +1,207 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// serviceMigrationServer serves the event rules, service orchestration and
+// active status of service P1 and records the changes made to them.
+type serviceMigrationServer struct {
+	path   *EventOrchestrationPath
+	active bool
+
+	activeUpdates []bool
+	pathUpdates   int
+
+	// rewrite changes paths as they are saved.
+	rewrite func(*EventOrchestrationPath)
+}
+
+func setupServiceMigration(t *testing.T) *serviceMigrationServer {
+	srv := &serviceMigrationServer{
+		path: &EventOrchestrationPath{
+			Type:   PathTypeService,
+			Parent: &EventOrchestrationPathReference{ID: "P1", Type: "service_reference"},
+			Sets:   []*EventOrchestrationPathSet{{ID: "start", Rules: []*EventOrchestrationPathRule{}}},
+			CatchAll: &EventOrchestrationPathCatchAll{
+				Actions: &EventOrchestrationPathRuleActions{},
+			},
+		},
+	}
+
+	// The event rules are served one per page.
+	mux.HandleFunc("/services/P1/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"limit": 1, "offset": 0, "more": true, "rules": [{
+				"id": "R1",
+				"disabled": false,
+				"conditions": {"operator": "and", "subconditions": [{"operator": "contains", "parameters": {"path": "payload.summary", "value": "disk"}}]},
+				"actions": {"severity": {"value": "critical"}}
+			}]}`))
+		case "1":
+			w.Write([]byte(`{"limit": 1, "offset": 1, "more": false, "rules": [{
+				"id": "R2",
+				"disabled": false,
+				"conditions": {"operator": "and", "subconditions": [{"operator": "equals", "parameters": {"path": "payload.source", "value": "db"}}]},
+				"actions": {"priority": {"value": "P1"}}
+			}]}`))
+		default:
+			t.Errorf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	})
+
+	mux.HandleFunc(fmt.Sprintf("%s/services/P1", eventOrchestrationBaseUrl), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			v := new(EventOrchestrationPathPayload)
+			json.NewDecoder(r.Body).Decode(v)
+			srv.path = v.OrchestrationPath
+			srv.pathUpdates++
+			// PagerDuty assigns its own IDs to the rules.
+			for _, set := range srv.path.Sets {
+				for i, rule := range set.Rules {
+					rule.ID = fmt.Sprintf("%s-%d", set.ID, i)
+				}
+			}
+			if srv.rewrite != nil {
+				srv.rewrite(srv.path)
+			}
+		case "GET":
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		json.NewEncoder(w).Encode(&EventOrchestrationPathPayload{OrchestrationPath: srv.path})
+	})
+
+	mux.HandleFunc(fmt.Sprintf("%s/services/P1/active", eventOrchestrationBaseUrl), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			v := new(EventOrchestrationPathServiceActiveStatus)
+			json.NewDecoder(r.Body).Decode(v)
+			srv.active = v.Active
+			srv.activeUpdates = append(srv.activeUpdates, v.Active)
+		}
+		json.NewEncoder(w).Encode(&EventOrchestrationPathServiceActiveStatus{Active: srv.active})
+	})
+
+	return srv
+}
+
+func TestEventOrchestrationPathServiceMigration(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupServiceMigration(t)
+	ctx := context.Background()
+
+	m, err := client.EventOrchestrationPaths.PlanServiceMigrationContext(ctx, "P1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.WasActive || srv.pathUpdates != 0 || len(srv.activeUpdates) != 0 {
+		t.Fatalf("planning changed the service: %+v", srv)
+	}
+	if want := "set start\n  + rule \"Event rule R1\" at 0\n  + rule \"Event rule R2\" at 1\n"; m.Diff.String() != want {
+		t.Errorf("diff %q; want %q", m.Diff, want)
+	}
+
+	if err := client.EventOrchestrationPaths.ApplyServiceMigrationContext(ctx, m, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !srv.active || srv.pathUpdates != 1 {
+		t.Errorf("migration not applied: %+v", srv)
+	}
+	rules := srv.path.Sets[0].Rules
+	if len(rules) != 2 || rules[0].Conditions[0].Expression != "event.summary matches part 'disk'" || rules[0].Actions.Severity != "critical" {
+		t.Errorf("unexpected rules %+v", rules)
+	}
+}
+
+func TestEventOrchestrationPathServiceMigrationRollback(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupServiceMigration(t)
+	ctx := context.Background()
+
+	m, err := client.EventOrchestrationPaths.PlanServiceMigrationContext(ctx, "P1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifyErr := errors.New("test events were not routed")
+	err = client.EventOrchestrationPaths.ApplyServiceMigrationContext(ctx, m, func(ctx context.Context, m *ServiceOrchestrationMigration) error {
+		return verifyErr
+	})
+	if err == nil || !strings.Contains(err.Error(), verifyErr.Error()) {
+		t.Fatalf("expected the verification error, got %v", err)
+	}
+
+	if want := []bool{true, false}; !reflect.DeepEqual(srv.activeUpdates, want) {
+		t.Errorf("active status updates %v; want %v", srv.activeUpdates, want)
+	}
+	if srv.pathUpdates != 2 || len(srv.path.Sets[0].Rules) != 0 {
+		t.Errorf("previous path was not restored: %+v", srv.path)
+	}
+}
+
+func TestEventOrchestrationPathServiceMigrationVerify(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupServiceMigration(t)
+	ctx := context.Background()
+
+	m, err := client.EventOrchestrationPaths.PlanServiceMigrationContext(ctx, "P1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another writer changes a rule, keeping the number of rules.
+	srv.rewrite = func(p *EventOrchestrationPath) {
+		if len(p.Sets[0].Rules) > 0 {
+			p.Sets[0].Rules[1].Actions.Priority = "P2"
+		}
+	}
+	err = client.EventOrchestrationPaths.ApplyServiceMigrationContext(ctx, m, nil)
+	if err == nil || !strings.Contains(err.Error(), `actions.priority: "P1" -> "P2"`) {
+		t.Fatalf("expected a verification error, got %v", err)
+	}
+	if srv.active || len(srv.path.Sets[0].Rules) != 0 {
+		t.Errorf("migration was not rolled back: %+v", srv.path)
+	}
+}
+
+func TestEventOrchestrationPathServiceMigrationIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupServiceMigration(t)
+	ctx := context.Background()
+
+	m, err := client.EventOrchestrationPaths.PlanServiceMigrationContext(ctx, "P1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Issues = append(m.Issues, &RulesetConversionIssue{ServiceID: "P1", RuleID: "R1", Message: "suppression thresholds are not supported"})
+
+	err = client.EventOrchestrationPaths.ApplyServiceMigrationContext(ctx, m, nil)
+	if err == nil || !strings.Contains(err.Error(), "AcceptIssues") {
+		t.Fatalf("expected the migration to be refused, got %v", err)
+	}
+	if srv.pathUpdates != 0 || len(srv.activeUpdates) != 0 {
+		t.Errorf("refused migration changed the service: %+v", srv)
+	}
+
+	m.AcceptIssues = true
+	if err := client.EventOrchestrationPaths.ApplyServiceMigrationContext(ctx, m, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !srv.active || srv.pathUpdates != 1 {
+		t.Errorf("migration not applied: %+v", srv)
+	}
+}
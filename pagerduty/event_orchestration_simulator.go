@@ -0,0 +1,481 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// OrchestrationSimulator predicts offline how Event Orchestration processes
+// an event: the global path first, then the router, then either the path of
+// the service the event was routed to or the unrouted path.
+type OrchestrationSimulator struct {
+	Global   *EventOrchestrationPath
+	Router   *EventOrchestrationPath
+	Unrouted *EventOrchestrationPath
+
+	// Services holds the service orchestration paths by service ID. An event
+	// routed to a service without a path keeps the result of the router.
+	Services map[string]*EventOrchestrationPath
+
+	// ServiceNames maps service names to service IDs for dynamic routing
+	// rules that look services up by name.
+	ServiceNames map[string]string
+
+	// CacheVariables holds the cache variable values the conditions see.
+	CacheVariables map[string]interface{}
+
+	// Now is the time used by now conditions. The current time is used when
+	// it is zero.
+	Now time.Time
+}
+
+// OrchestrationSimulation is the outcome of simulating an event.
+type OrchestrationSimulation struct {
+	// MatchedRules lists the rules whose actions were applied, in order.
+	MatchedRules []*OrchestrationSimulationRule
+
+	// ServiceID is the service the event was routed to. It is empty when the
+	// event was dropped or left unrouted.
+	ServiceID string
+	Unrouted  bool
+	Dropped   bool
+
+	// Event is the event after all severity changes and extractions.
+	Event *OrchestrationEvent
+
+	Suppressed                 bool
+	Suspend                    *int
+	Priority                   string
+	EventAction                string
+	EscalationPolicy           *string
+	Annotations                []string
+	IncidentCustomFieldUpdates []*EventOrchestrationPathIncidentCustomFieldUpdate
+	PagerdutyAutomationActions []*EventOrchestrationPathPagerdutyAutomationAction
+	AutomationActions          []*EventOrchestrationPathAutomationAction
+}
+
+// OrchestrationSimulationRule identifies a rule applied during a
+// simulation. CatchAll is set when the catch-all actions of a path were
+// applied; SetID and RuleID are then empty.
+type OrchestrationSimulationRule struct {
+	PathType  string
+	ServiceID string
+	SetID     string
+	RuleID    string
+	Label     string
+	CatchAll  bool
+}
+
+func (r *OrchestrationSimulationRule) String() string {
+	where := r.PathType
+	if r.ServiceID != "" {
+		where += " " + r.ServiceID
+	}
+	if r.CatchAll {
+		return where + " catch_all"
+	}
+	if r.Label != "" {
+		return fmt.Sprintf("%s set %s rule %s (%s)", where, r.SetID, r.RuleID, r.Label)
+	}
+	return fmt.Sprintf("%s set %s rule %s", where, r.SetID, r.RuleID)
+}
+
+// Simulate runs an event through the orchestration paths.
+func (s *OrchestrationSimulator) Simulate(event *OrchestrationEvent) (*OrchestrationSimulation, error) {
+	res := &OrchestrationSimulation{Event: cloneOrchestrationEvent(event)}
+
+	if s.Global != nil {
+		if err := s.runPath(res, PathTypeGlobal, "", s.Global); err != nil {
+			return nil, err
+		}
+		if res.Dropped {
+			return res, nil
+		}
+	}
+
+	serviceID, err := s.route(res)
+	if err != nil {
+		return nil, err
+	}
+	if serviceID == "" || serviceID == "unrouted" {
+		res.Unrouted = true
+		if s.Unrouted != nil {
+			if err := s.runPath(res, PathTypeUnrouted, "", s.Unrouted); err != nil {
+				return nil, err
+			}
+		}
+		return res, nil
+	}
+
+	res.ServiceID = serviceID
+	if path := s.Services[serviceID]; path != nil {
+		if err := s.runPath(res, PathTypeService, serviceID, path); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (s *OrchestrationSimulator) context(res *OrchestrationSimulation) *PCLContext {
+	return &PCLContext{
+		Event:          res.Event,
+		Variables:      make(map[string]interface{}),
+		CacheVariables: s.CacheVariables,
+		Now:            s.Now,
+	}
+}
+
+// route evaluates the router rules and returns the service ID the event is
+// routed to, or "unrouted".
+func (s *OrchestrationSimulator) route(res *OrchestrationSimulation) (string, error) {
+	if s.Router == nil {
+		return "unrouted", nil
+	}
+	ctx := s.context(res)
+
+	for _, set := range s.Router.Sets {
+		for _, rule := range set.Rules {
+			if rule.Disabled {
+				continue
+			}
+			ok, err := EvaluatePCLConditions(rule.Conditions, ctx)
+			if err != nil {
+				return "", fmt.Errorf("router rule %s: %v", rule.ID, err)
+			}
+			if !ok || rule.Actions == nil {
+				continue
+			}
+
+			if d := rule.Actions.DynamicRouteTo; d != nil {
+				id, err := s.dynamicRoute(d, ctx)
+				if err != nil {
+					return "", fmt.Errorf("router rule %s: %v", rule.ID, err)
+				}
+				// Events a dynamic route cannot resolve fall through to
+				// the next rules.
+				if id == "" {
+					continue
+				}
+				res.MatchedRules = append(res.MatchedRules, &OrchestrationSimulationRule{PathType: PathTypeRouter, SetID: set.ID, RuleID: rule.ID, Label: rule.Label})
+				return id, nil
+			}
+
+			res.MatchedRules = append(res.MatchedRules, &OrchestrationSimulationRule{PathType: PathTypeRouter, SetID: set.ID, RuleID: rule.ID, Label: rule.Label})
+			return rule.Actions.RouteTo, nil
+		}
+	}
+
+	if s.Router.CatchAll != nil && s.Router.CatchAll.Actions != nil {
+		res.MatchedRules = append(res.MatchedRules, &OrchestrationSimulationRule{PathType: PathTypeRouter, CatchAll: true})
+		return s.Router.CatchAll.Actions.RouteTo, nil
+	}
+	return "unrouted", nil
+}
+
+func (s *OrchestrationSimulator) dynamicRoute(d *EventOrchestrationPathDynamicRouteTo, ctx *PCLContext) (string, error) {
+	value, err := orchestrationExtract(d.Source, d.Regex, ctx)
+	if err != nil || value == "" {
+		return "", err
+	}
+
+	switch d.LookupBy {
+	case "service_name":
+		return s.ServiceNames[value], nil
+	case "service_id", "":
+		if _, ok := s.Services[value]; ok {
+			return value, nil
+		}
+		for _, id := range s.ServiceNames {
+			if id == value {
+				return value, nil
+			}
+		}
+		return "", nil
+	}
+	return "", fmt.Errorf("unsupported dynamic route lookup_by %q", d.LookupBy)
+}
+
+// runPath evaluates the sets of a global, unrouted or service path starting
+// with the "start" set and following route_to actions to other sets. When
+// no rule of a set matches, the catch-all actions are applied.
+func (s *OrchestrationSimulator) runPath(res *OrchestrationSimulation, pathType, serviceID string, path *EventOrchestrationPath) error {
+	sets := make(map[string]*EventOrchestrationPathSet, len(path.Sets))
+	for _, set := range path.Sets {
+		sets[set.ID] = set
+	}
+	ctx := s.context(res)
+
+	catchAll := func() error {
+		if path.CatchAll == nil || path.CatchAll.Actions == nil {
+			return nil
+		}
+		res.MatchedRules = append(res.MatchedRules, &OrchestrationSimulationRule{PathType: pathType, ServiceID: serviceID, CatchAll: true})
+		return res.apply(path.CatchAll.Actions, ctx)
+	}
+	if len(path.Sets) == 0 {
+		return catchAll()
+	}
+
+	setID := "start"
+	if _, ok := sets[setID]; !ok {
+		setID = path.Sets[0].ID
+	}
+	visited := make(map[string]bool)
+
+	for setID != "" {
+		set, ok := sets[setID]
+		if !ok {
+			return fmt.Errorf("%s path routes to unknown set %q", pathType, setID)
+		}
+		if visited[setID] {
+			return fmt.Errorf("%s path routes back to set %q", pathType, setID)
+		}
+		visited[setID] = true
+
+		var matched *EventOrchestrationPathRule
+		for _, rule := range set.Rules {
+			if rule.Disabled {
+				continue
+			}
+			ok, err := EvaluatePCLConditions(rule.Conditions, ctx)
+			if err != nil {
+				return fmt.Errorf("%s rule %s: %v", pathType, rule.ID, err)
+			}
+			if ok {
+				matched = rule
+				break
+			}
+		}
+
+		if matched == nil {
+			return catchAll()
+		}
+
+		res.MatchedRules = append(res.MatchedRules, &OrchestrationSimulationRule{PathType: pathType, ServiceID: serviceID, SetID: set.ID, RuleID: matched.ID, Label: matched.Label})
+		if matched.Actions == nil {
+			return nil
+		}
+		if err := res.apply(matched.Actions, ctx); err != nil {
+			return fmt.Errorf("%s rule %s: %v", pathType, matched.ID, err)
+		}
+		if res.Dropped {
+			return nil
+		}
+		setID = matched.Actions.RouteTo
+	}
+	return nil
+}
+
+// apply records the actions of a rule. Variables are set first so that
+// extractions of the same rule can use them.
+func (res *OrchestrationSimulation) apply(a *EventOrchestrationPathRuleActions, ctx *PCLContext) error {
+	for _, v := range a.Variables {
+		value, err := orchestrationExtract(v.Path, v.Value, ctx)
+		if err != nil {
+			return fmt.Errorf("variable %s: %v", v.Name, err)
+		}
+		if value != "" {
+			ctx.Variables[v.Name] = value
+		}
+	}
+
+	for _, e := range a.Extractions {
+		var value string
+		var err error
+		if e.Template != "" {
+			value = orchestrationRenderTemplate(e.Template, ctx)
+		} else {
+			value, err = orchestrationExtract(e.Source, e.Regex, ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("extraction to %s: %v", e.Target, err)
+		}
+		if err := setOrchestrationEventField(res.Event, e.Target, value); err != nil {
+			return err
+		}
+	}
+
+	if a.DropEvent {
+		res.Dropped = true
+	}
+	if a.Suppress {
+		res.Suppressed = true
+	}
+	if a.Suspend != nil {
+		res.Suspend = a.Suspend
+	}
+	if a.Severity != "" {
+		res.Event.Severity = a.Severity
+	}
+	if a.Priority != "" {
+		res.Priority = a.Priority
+	}
+	if a.EventAction != "" {
+		res.EventAction = a.EventAction
+	}
+	if a.Annotate != "" {
+		res.Annotations = append(res.Annotations, a.Annotate)
+	}
+	if a.EscalationPolicy != nil {
+		res.EscalationPolicy = a.EscalationPolicy
+	}
+	res.IncidentCustomFieldUpdates = append(res.IncidentCustomFieldUpdates, a.IncidentCustomFieldUpdates...)
+	res.PagerdutyAutomationActions = append(res.PagerdutyAutomationActions, a.PagerdutyAutomationActions...)
+	res.AutomationActions = append(res.AutomationActions, a.AutomationActions...)
+	return nil
+}
+
+// splitOrchestrationPath splits a field path such as
+// event.custom_details.host into its segments.
+func splitOrchestrationPath(path string) ([]string, error) {
+	p := &pclParser{src: path}
+	tok, n, err := p.readWord(0)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(path) || tok.path == nil {
+		return nil, fmt.Errorf("invalid field path %q", path)
+	}
+	for _, seg := range tok.path {
+		if seg == "" {
+			return nil, fmt.Errorf("invalid field path %q", path)
+		}
+	}
+	return tok.path, nil
+}
+
+// orchestrationExtract matches regex against the field at source. The
+// capture groups are concatenated, or the whole match is returned if the
+// regex has none. It returns an empty string when the field is missing or
+// the regex does not match.
+func orchestrationExtract(source, regex string, ctx *PCLContext) (string, error) {
+	path, err := splitOrchestrationPath(source)
+	if err != nil {
+		return "", err
+	}
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		return "", fmt.Errorf("invalid regex %q: %v", regex, err)
+	}
+
+	v, ok := ctx.lookup(path)
+	if !ok {
+		return "", nil
+	}
+	m := re.FindStringSubmatch(pclString(v))
+	if m == nil {
+		return "", nil
+	}
+	if len(m) == 1 {
+		return m[0], nil
+	}
+	return strings.Join(m[1:], ""), nil
+}
+
+var orchestrationTemplateVar = regexp.MustCompile(`\{\{\s*([^{}\s]+)\s*\}\}`)
+
+// orchestrationRenderTemplate replaces {{path}} placeholders, such as
+// {{variables.host}} or {{event.summary}}, with the value of the field.
+func orchestrationRenderTemplate(template string, ctx *PCLContext) string {
+	return orchestrationTemplateVar.ReplaceAllStringFunc(template, func(m string) string {
+		path, err := splitOrchestrationPath(orchestrationTemplateVar.FindStringSubmatch(m)[1])
+		if err != nil {
+			return ""
+		}
+		v, ok := ctx.lookup(path)
+		if !ok {
+			return ""
+		}
+		return pclString(v)
+	})
+}
+
+// setOrchestrationEventField sets an extraction target such as
+// event.summary or event.custom_details.host.
+func setOrchestrationEventField(e *OrchestrationEvent, target, value string) error {
+	path, err := splitOrchestrationPath(target)
+	if err != nil {
+		return err
+	}
+	if path[0] != "event" {
+		return fmt.Errorf("unsupported extraction target %q", target)
+	}
+
+	if path[1] == "custom_details" && len(path) > 2 {
+		if e.CustomDetails == nil {
+			e.CustomDetails = make(map[string]interface{})
+		}
+		m := e.CustomDetails
+		for _, seg := range path[2 : len(path)-1] {
+			next, ok := m[seg].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				m[seg] = next
+			}
+			m = next
+		}
+		m[path[len(path)-1]] = value
+		return nil
+	}
+	if len(path) != 2 {
+		return fmt.Errorf("unsupported extraction target %q", target)
+	}
+
+	switch path[1] {
+	case "summary":
+		e.Summary = value
+	case "source":
+		e.Source = value
+	case "severity":
+		e.Severity = value
+	case "timestamp":
+		e.Timestamp = value
+	case "component":
+		e.Component = value
+	case "group":
+		e.Group = value
+	case "class":
+		e.Class = value
+	case "event_action":
+		e.EventAction = value
+	case "dedup_key":
+		e.DedupKey = value
+	default:
+		return fmt.Errorf("unsupported extraction target %q", target)
+	}
+	return nil
+}
+
+func cloneOrchestrationEvent(e *OrchestrationEvent) *OrchestrationEvent {
+	if e == nil {
+		return &OrchestrationEvent{}
+	}
+	c := *e
+	if e.CustomDetails != nil {
+		c.CustomDetails = cloneOrchestrationValue(e.CustomDetails).(map[string]interface{})
+	}
+	if e.RawEvent != nil {
+		c.RawEvent = cloneOrchestrationValue(e.RawEvent).(map[string]interface{})
+	}
+	return &c
+}
+
+func cloneOrchestrationValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = cloneOrchestrationValue(e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, e := range v {
+			s[i] = cloneOrchestrationValue(e)
+		}
+		return s
+	}
+	return v
+}
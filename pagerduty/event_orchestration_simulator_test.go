@@ -0,0 +1,216 @@
+package pagerduty
+
+import (
+	"reflect"
+	"testing"
+)
+
+func simulationRules(res *OrchestrationSimulation) []string {
+	var out []string
+	for _, r := range res.MatchedRules {
+		out = append(out, r.String())
+	}
+	return out
+}
+
+func testOrchestrationSimulator() *OrchestrationSimulator {
+	return &OrchestrationSimulator{
+		Global: &EventOrchestrationPath{
+			Type: PathTypeGlobal,
+			Sets: []*EventOrchestrationPathSet{
+				{
+					ID: "start",
+					Rules: []*EventOrchestrationPathRule{
+						{
+							ID:         "drop",
+							Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.summary matches part 'heartbeat'"}},
+							Actions:    &EventOrchestrationPathRuleActions{DropEvent: true},
+						},
+						{
+							ID:         "db",
+							Label:      "Databases",
+							Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.source matches part 'db'"}},
+							Actions: &EventOrchestrationPathRuleActions{
+								RouteTo: "db",
+								Variables: []*EventOrchestrationPathActionVariables{
+									{Name: "host", Path: "event.source", Type: "regex", Value: `^([a-z0-9-]+)\.`},
+								},
+								Extractions: []*EventOrchestrationPathActionExtractions{
+									{Target: "event.custom_details.host", Template: "{{variables.host}}"},
+								},
+							},
+						},
+					},
+				},
+				{
+					ID: "db",
+					Rules: []*EventOrchestrationPathRule{
+						{
+							ID:         "critical",
+							Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "variables.host matches 'db-1'"}},
+							Actions:    &EventOrchestrationPathRuleActions{Severity: "critical", Annotate: "primary database"},
+						},
+					},
+				},
+			},
+			CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{Priority: "P3"}},
+		},
+		Router: &EventOrchestrationPath{
+			Type: PathTypeRouter,
+			Sets: []*EventOrchestrationPathSet{
+				{
+					ID: "start",
+					Rules: []*EventOrchestrationPathRule{
+						{
+							ID:       "disabled",
+							Disabled: true,
+							Actions:  &EventOrchestrationPathRuleActions{RouteTo: "PDISABLED"},
+						},
+						{
+							ID:         "dynamic",
+							Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.custom_details.service exists"}},
+							Actions: &EventOrchestrationPathRuleActions{
+								DynamicRouteTo: &EventOrchestrationPathDynamicRouteTo{Source: "event.custom_details.service", Regex: "(.*)", LookupBy: "service_name"},
+							},
+						},
+						{
+							ID:         "db",
+							Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.custom_details.host exists"}},
+							Actions:    &EventOrchestrationPathRuleActions{RouteTo: "PDB"},
+						},
+					},
+				},
+			},
+			CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{RouteTo: "unrouted"}},
+		},
+		Unrouted: &EventOrchestrationPath{
+			Type:     PathTypeUnrouted,
+			CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{Severity: "info"}},
+		},
+		Services: map[string]*EventOrchestrationPath{
+			"PDB": {
+				Type: PathTypeService,
+				Sets: []*EventOrchestrationPathSet{
+					{
+						ID: "start",
+						Rules: []*EventOrchestrationPathRule{
+							{
+								ID:         "suppress",
+								Conditions: []*EventOrchestrationPathRuleCondition{{Expression: "event.severity matches 'warning'"}},
+								Actions:    &EventOrchestrationPathRuleActions{Suppress: true},
+							},
+						},
+					},
+				},
+				CatchAll: &EventOrchestrationPathCatchAll{Actions: &EventOrchestrationPathRuleActions{
+					PagerdutyAutomationActions: []*EventOrchestrationPathPagerdutyAutomationAction{{ActionId: "A1"}},
+				}},
+			},
+		},
+		ServiceNames: map[string]string{"web": "PWEB", "db": "PDB"},
+	}
+}
+
+func TestOrchestrationSimulatorRoutesToService(t *testing.T) {
+	s := testOrchestrationSimulator()
+	event := &OrchestrationEvent{Summary: "Disk full", Source: "db-1.prod", Severity: "warning"}
+
+	res, err := s.Simulate(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantRules := []string{
+		"global set start rule db (Databases)",
+		"global set db rule critical",
+		"router set start rule db",
+		"service PDB catch_all",
+	}
+	if got := simulationRules(res); !reflect.DeepEqual(got, wantRules) {
+		t.Errorf("matched rules %q; want %q", got, wantRules)
+	}
+	if res.ServiceID != "PDB" || res.Unrouted || res.Dropped || res.Suppressed {
+		t.Errorf("unexpected result %+v", res)
+	}
+	if res.Event.Severity != "critical" || res.Event.CustomDetails["host"] != "db-1" {
+		t.Errorf("unexpected event %+v", res.Event)
+	}
+	if !reflect.DeepEqual(res.Annotations, []string{"primary database"}) {
+		t.Errorf("annotations %q", res.Annotations)
+	}
+	if len(res.PagerdutyAutomationActions) != 1 || res.PagerdutyAutomationActions[0].ActionId != "A1" {
+		t.Errorf("automation actions %+v", res.PagerdutyAutomationActions)
+	}
+	if event.Severity != "warning" || event.CustomDetails != nil {
+		t.Errorf("input event was modified: %+v", event)
+	}
+}
+
+func TestOrchestrationSimulatorDynamicRoute(t *testing.T) {
+	s := testOrchestrationSimulator()
+
+	res, err := s.Simulate(&OrchestrationEvent{Summary: "5xx", Source: "lb", CustomDetails: map[string]interface{}{"service": "web"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ServiceID != "PWEB" {
+		t.Errorf("routed to %q; want PWEB", res.ServiceID)
+	}
+	if res.Priority != "P3" {
+		t.Errorf("priority %q; want P3 from the global catch-all", res.Priority)
+	}
+
+	// An unknown service name falls through to the next rules.
+	res, err = s.Simulate(&OrchestrationEvent{Summary: "5xx", Source: "lb", CustomDetails: map[string]interface{}{"service": "api"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"global catch_all", "router catch_all", "unrouted catch_all"}
+	if got := simulationRules(res); !reflect.DeepEqual(got, want) {
+		t.Errorf("matched rules %q; want %q", got, want)
+	}
+	if !res.Unrouted || res.Event.Severity != "info" {
+		t.Errorf("unexpected result %+v", res)
+	}
+}
+
+func TestOrchestrationSimulatorDrop(t *testing.T) {
+	s := testOrchestrationSimulator()
+
+	res, err := s.Simulate(&OrchestrationEvent{Summary: "heartbeat from db-1", Source: "db-1.prod"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Dropped || res.ServiceID != "" || res.Unrouted {
+		t.Errorf("unexpected result %+v", res)
+	}
+	if got := simulationRules(res); !reflect.DeepEqual(got, []string{"global set start rule drop"}) {
+		t.Errorf("matched rules %q", got)
+	}
+}
+
+func TestOrchestrationSimulatorErrors(t *testing.T) {
+	s := &OrchestrationSimulator{
+		Global: &EventOrchestrationPath{
+			Sets: []*EventOrchestrationPathSet{
+				{ID: "start", Rules: []*EventOrchestrationPathRule{{ID: "a", Actions: &EventOrchestrationPathRuleActions{RouteTo: "next"}}}},
+				{ID: "next", Rules: []*EventOrchestrationPathRule{{ID: "b", Actions: &EventOrchestrationPathRuleActions{RouteTo: "start"}}}},
+			},
+		},
+	}
+	if _, err := s.Simulate(&OrchestrationEvent{}); err == nil {
+		t.Error("expected an error for a set loop")
+	}
+
+	s.Global.Sets[1].Rules[0].Actions.RouteTo = "missing"
+	if _, err := s.Simulate(&OrchestrationEvent{}); err == nil {
+		t.Error("expected an error for an unknown set")
+	}
+
+	s.Global.Sets[1].Rules[0].Actions = &EventOrchestrationPathRuleActions{
+		Extractions: []*EventOrchestrationPathActionExtractions{{Target: "event.summary", Source: "event.source", Regex: "(["}},
+	}
+	if _, err := s.Simulate(&OrchestrationEvent{}); err == nil {
+		t.Error("expected an error for an invalid regex")
+	}
+}
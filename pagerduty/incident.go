@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 )
@@ -34,6 +35,7 @@ type Incident struct {
 	EscalationPolicy     *EscalationPolicyReference  `json:"escalation_policy,omitempty"`
 	Teams                []*TeamReference            `json:"teams,omitempty"`
 	Urgency              string                      `json:"urgency,omitempty"`
+	EscalationLevel      int                         `json:"escalation_level,omitempty"`
 }
 
 type AlertCounts struct {
@@ -91,6 +93,15 @@ type ManageIncidentsOptions struct {
 	Limit  int `url:"limit,omitempty"`
 	Offset int `url:"offset,omitempty"`
 	Total  int `url:"total,omitempty"`
+
+	// From overrides Config.DefaultFrom as the requester email.
+	From string `url:"-"`
+}
+
+// CreateIncidentOptions represents options when creating an incident.
+type CreateIncidentOptions struct {
+	// From overrides Config.DefaultFrom as the requester email.
+	From string
 }
 
 // ListIncidentsResponse represents a list response of incidents.
@@ -143,7 +154,16 @@ func (s *IncidentService) ManageIncidents(incidents []*Incident, o *ManageIncide
 	u := "/incidents"
 	v := new(ManageIncidentsResponse)
 
-	resp, err := s.client.newRequestDo("PUT", u, o, &ManageIncidentsPayload{Incidents: incidents}, &v)
+	if o == nil {
+		o = &ManageIncidentsOptions{}
+	}
+
+	ro, err := s.client.fromHeader(o.From)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.newRequestDoOptions("PUT", u, o, &ManageIncidentsPayload{Incidents: incidents}, &v, ro)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -153,10 +173,31 @@ func (s *IncidentService) ManageIncidents(incidents []*Incident, o *ManageIncide
 
 // Create an incident
 func (s *IncidentService) Create(incident *Incident) (*Incident, *Response, error) {
+	return s.CreateWithOptions(incident, nil)
+}
+
+// CreateWithOptions creates an incident on behalf of the requester given in
+// the options, falling back to Config.DefaultFrom.
+func (s *IncidentService) CreateWithOptions(incident *Incident, o *CreateIncidentOptions) (*Incident, *Response, error) {
+	return s.CreateWithOptionsContext(context.Background(), incident, o)
+}
+
+// CreateWithOptionsContext creates an incident on behalf of the requester
+// given in the options, falling back to Config.DefaultFrom.
+func (s *IncidentService) CreateWithOptionsContext(ctx context.Context, incident *Incident, o *CreateIncidentOptions) (*Incident, *Response, error) {
 	u := "/incidents"
 	v := new(IncidentPayload)
 
-	resp, err := s.client.newRequestDo("POST", u, nil, &IncidentPayload{Incident: incident}, &v)
+	if o == nil {
+		o = &CreateIncidentOptions{}
+	}
+
+	ro, err := s.client.fromHeader(o.From)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &IncidentPayload{Incident: incident}, &v, ro)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -176,3 +217,275 @@ func (s *IncidentService) Get(id string) (*Incident, *Response, error) {
 
 	return v.Incident, resp, nil
 }
+
+// MergeIncidentsPayload represents the payload used to merge incidents.
+type MergeIncidentsPayload struct {
+	SourceIncidents []*IncidentReference `json:"source_incidents"`
+}
+
+// SnoozeIncidentPayload represents the payload used to snooze an incident.
+type SnoozeIncidentPayload struct {
+	Duration int `json:"duration"`
+}
+
+// IncidentAssignmentTarget represents an assignee of an incident when
+// reassigning it.
+type IncidentAssignmentTarget struct {
+	Assignee *UserReference `json:"assignee"`
+}
+
+// incidentUpdate represents the fields of an incident that can be changed
+// through the single incident update endpoint.
+type incidentUpdate struct {
+	Type             string                      `json:"type"`
+	Assignments      []*IncidentAssignmentTarget `json:"assignments,omitempty"`
+	EscalationPolicy *EscalationPolicyReference  `json:"escalation_policy,omitempty"`
+	EscalationLevel  int                         `json:"escalation_level,omitempty"`
+}
+
+type incidentUpdatePayload struct {
+	Incident *incidentUpdate `json:"incident"`
+}
+
+// ResponderRequest represents a request for additional responders on an
+// incident.
+type ResponderRequest struct {
+	RequesterID             string                           `json:"requester_id,omitempty"`
+	Requester               *UserReference                   `json:"requester,omitempty"`
+	Incident                *IncidentReference               `json:"incident,omitempty"`
+	RequestedAt             string                           `json:"requested_at,omitempty"`
+	Message                 string                           `json:"message,omitempty"`
+	ResponderRequestTargets []*ResponderRequestTargetWrapper `json:"responder_request_targets,omitempty"`
+}
+
+// ResponderRequestTargetWrapper is a wrapper around ResponderRequestTarget
+type ResponderRequestTargetWrapper struct {
+	Target *ResponderRequestTarget `json:"responder_request_target,omitempty"`
+}
+
+// ResponderRequestTarget represents a user or escalation policy requested
+// to respond to an incident.
+type ResponderRequestTarget struct {
+	ID                  string                `json:"id,omitempty"`
+	Type                string                `json:"type,omitempty"`
+	Summary             string                `json:"summary,omitempty"`
+	IncidentsResponders []*IncidentResponders `json:"incidents_responders,omitempty"`
+}
+
+// IncidentResponders represents the state of a responder on an incident.
+type IncidentResponders struct {
+	State       string             `json:"state,omitempty"`
+	User        *UserReference     `json:"user,omitempty"`
+	Incident    *IncidentReference `json:"incident,omitempty"`
+	UpdatedAt   string             `json:"updated_at,omitempty"`
+	Message     string             `json:"message,omitempty"`
+	Requester   *UserReference     `json:"requester,omitempty"`
+	RequestedAt string             `json:"requested_at,omitempty"`
+}
+
+// ResponderRequestPayload represents a responder request.
+type ResponderRequestPayload struct {
+	ResponderRequest *ResponderRequest `json:"responder_request,omitempty"`
+}
+
+// IncidentStatusUpdate represents a status update posted to an incident.
+type IncidentStatusUpdate struct {
+	ID          string         `json:"id,omitempty"`
+	Message     string         `json:"message,omitempty"`
+	Subject     string         `json:"subject,omitempty"`
+	HTMLMessage string         `json:"html_message,omitempty"`
+	CreatedAt   string         `json:"created_at,omitempty"`
+	Sender      *UserReference `json:"sender,omitempty"`
+}
+
+// IncidentStatusUpdatePayload represents an incident status update.
+type IncidentStatusUpdatePayload struct {
+	StatusUpdate *IncidentStatusUpdate `json:"status_update,omitempty"`
+}
+
+// Merge merges the given source incidents into an existing incident on behalf
+// of the requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) Merge(id, from string, sourceIncidentIDs []string) (*Incident, *Response, error) {
+	return s.MergeContext(context.Background(), id, from, sourceIncidentIDs)
+}
+
+// MergeContext merges the given source incidents into an existing incident
+// on behalf of the requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) MergeContext(ctx context.Context, id, from string, sourceIncidentIDs []string) (*Incident, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/merge", id)
+	v := new(IncidentPayload)
+
+	p := &MergeIncidentsPayload{SourceIncidents: make([]*IncidentReference, 0, len(sourceIncidentIDs))}
+	for _, sourceID := range sourceIncidentIDs {
+		p.SourceIncidents = append(p.SourceIncidents, &IncidentReference{ID: sourceID, Type: "incident_reference"})
+	}
+
+	ro, err := s.client.fromHeader(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, p, v, ro)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Incident, resp, nil
+}
+
+// Snooze snoozes an acknowledged incident for the given duration in seconds
+// on behalf of the requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) Snooze(id, from string, duration int) (*Incident, *Response, error) {
+	return s.SnoozeContext(context.Background(), id, from, duration)
+}
+
+// SnoozeContext snoozes an acknowledged incident for the given duration in
+// seconds on behalf of the requester email from, falling back to
+// Config.DefaultFrom.
+func (s *IncidentService) SnoozeContext(ctx context.Context, id, from string, duration int) (*Incident, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/snooze", id)
+	v := new(IncidentPayload)
+
+	ro, err := s.client.fromHeader(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, &SnoozeIncidentPayload{Duration: duration}, v, ro)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Incident, resp, nil
+}
+
+// ReassignToUsers reassigns an incident to the given users on behalf of the
+// requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) ReassignToUsers(id, from string, userIDs []string) (*Incident, *Response, error) {
+	return s.ReassignToUsersContext(context.Background(), id, from, userIDs)
+}
+
+// ReassignToUsersContext reassigns an incident to the given users on behalf
+// of the requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) ReassignToUsersContext(ctx context.Context, id, from string, userIDs []string) (*Incident, *Response, error) {
+	update := &incidentUpdate{
+		Type:        "incident_reference",
+		Assignments: make([]*IncidentAssignmentTarget, 0, len(userIDs)),
+	}
+	for _, userID := range userIDs {
+		update.Assignments = append(update.Assignments, &IncidentAssignmentTarget{
+			Assignee: &UserReference{ID: userID, Type: "user_reference"},
+		})
+	}
+
+	return s.update(ctx, id, from, update)
+}
+
+// ReassignToEscalationPolicy reassigns an incident to an escalation policy on
+// behalf of the requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) ReassignToEscalationPolicy(id, from, escalationPolicyID string) (*Incident, *Response, error) {
+	return s.ReassignToEscalationPolicyContext(context.Background(), id, from, escalationPolicyID)
+}
+
+// ReassignToEscalationPolicyContext reassigns an incident to an escalation
+// policy on behalf of the requester email from, falling back to
+// Config.DefaultFrom.
+func (s *IncidentService) ReassignToEscalationPolicyContext(ctx context.Context, id, from, escalationPolicyID string) (*Incident, *Response, error) {
+	update := &incidentUpdate{
+		Type: "incident_reference",
+		EscalationPolicy: &EscalationPolicyReference{
+			ID:   escalationPolicyID,
+			Type: "escalation_policy_reference",
+		},
+	}
+
+	return s.update(ctx, id, from, update)
+}
+
+// Escalate escalates an incident to the given level of its escalation policy
+// on behalf of the requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) Escalate(id, from string, level int) (*Incident, *Response, error) {
+	return s.EscalateContext(context.Background(), id, from, level)
+}
+
+// EscalateContext escalates an incident to the given level of its escalation
+// policy on behalf of the requester email from, falling back to
+// Config.DefaultFrom.
+func (s *IncidentService) EscalateContext(ctx context.Context, id, from string, level int) (*Incident, *Response, error) {
+	if level < 1 {
+		return nil, nil, fmt.Errorf("escalation level must be greater than zero, got %d", level)
+	}
+
+	return s.update(ctx, id, from, &incidentUpdate{
+		Type:            "incident_reference",
+		EscalationLevel: level,
+	})
+}
+
+func (s *IncidentService) update(ctx context.Context, id, from string, update *incidentUpdate) (*Incident, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s", id)
+	v := new(IncidentPayload)
+
+	ro, err := s.client.fromHeader(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "PUT", u, nil, &incidentUpdatePayload{Incident: update}, v, ro)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Incident, resp, nil
+}
+
+// CreateResponderRequest requests additional responders for an incident on
+// behalf of the requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) CreateResponderRequest(id, from string, r *ResponderRequest) (*ResponderRequest, *Response, error) {
+	return s.CreateResponderRequestContext(context.Background(), id, from, r)
+}
+
+// CreateResponderRequestContext requests additional responders for an
+// incident on behalf of the requester email from, falling back to
+// Config.DefaultFrom.
+func (s *IncidentService) CreateResponderRequestContext(ctx context.Context, id, from string, r *ResponderRequest) (*ResponderRequest, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/responder_requests", id)
+	v := new(ResponderRequestPayload)
+
+	ro, err := s.client.fromHeader(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, r, v, ro)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.ResponderRequest, resp, nil
+}
+
+// CreateStatusUpdate posts a status update to an incident on behalf of the
+// requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) CreateStatusUpdate(id, from string, update *IncidentStatusUpdate) (*IncidentStatusUpdate, *Response, error) {
+	return s.CreateStatusUpdateContext(context.Background(), id, from, update)
+}
+
+// CreateStatusUpdateContext posts a status update to an incident on behalf of
+// the requester email from, falling back to Config.DefaultFrom.
+func (s *IncidentService) CreateStatusUpdateContext(ctx context.Context, id, from string, update *IncidentStatusUpdate) (*IncidentStatusUpdate, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/status_updates", id)
+	v := new(IncidentStatusUpdatePayload)
+
+	ro, err := s.client.fromHeader(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.newRequestDoOptionsContext(ctx, "POST", u, nil, update, v, ro)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.StatusUpdate, resp, nil
+}
@@ -23,6 +23,8 @@ type IncidentCustomField struct {
 	Description  *string                      `json:"description,omitempty"`
 	DefaultValue interface{}                  `json:"default_value,omitempty"`
 	FieldOptions []*IncidentCustomFieldOption `json:"field_options,omitempty"`
+	Enabled      *bool                        `json:"enabled,omitempty"`
+	IncidentType string                       `json:"incident_type,omitempty"`
 }
 
 type rawIncidentCustomField struct {
@@ -37,6 +39,8 @@ type rawIncidentCustomField struct {
 	Description  *string                      `json:"description,omitempty"`
 	DefaultValue interface{}                  `json:"default_value,omitempty"`
 	FieldOptions []*IncidentCustomFieldOption `json:"field_options,omitempty"`
+	Enabled      *bool                        `json:"enabled,omitempty"`
+	IncidentType string                       `json:"incident_type,omitempty"`
 }
 
 func (d *IncidentCustomField) UnmarshalJSON(data []byte) error {
@@ -56,6 +60,8 @@ func (d *IncidentCustomField) UnmarshalJSON(data []byte) error {
 		FieldType:    p.FieldType,
 		Description:  p.Description,
 		FieldOptions: p.FieldOptions,
+		Enabled:      p.Enabled,
+		IncidentType: p.IncidentType,
 	}
 	if p.DefaultValue != nil {
 		switch p.DataType {
@@ -72,32 +78,41 @@ func (d *IncidentCustomField) UnmarshalJSON(data []byte) error {
 }
 
 func (d *IncidentCustomField) convertForInt(value interface{}) error {
+	v, err := convertIncidentCustomFieldInt(d.FieldType, value)
+	if err != nil {
+		return err
+	}
+	d.DefaultValue = v
+	return nil
+}
+
+// convertIncidentCustomFieldInt converts a decoded JSON value of an integer
+// field, which encoding/json yields as float64, into int64 values.
+func convertIncidentCustomFieldInt(fieldType IncidentCustomFieldFieldType, value interface{}) (interface{}, error) {
 	switch v := value.(type) {
 	case []interface{}:
-		if d.FieldType.IsMultiValue() {
+		if fieldType.IsMultiValue() {
 			var s []interface{}
 			for _, f := range v {
 				switch ev := f.(type) {
 				case float64:
 					s = append(s, int64(math.Round(ev)))
 				default:
-					return fmt.Errorf("received unexpected %T as an element in a multi-value int", ev)
+					return nil, fmt.Errorf("received unexpected %T as an element in a multi-value int", ev)
 				}
 			}
-			d.DefaultValue = s
-			return nil
+			return s, nil
 		} else {
-			return fmt.Errorf("received unexpected %T for non-multi-value int", v)
+			return nil, fmt.Errorf("received unexpected %T for non-multi-value int", v)
 		}
 	case float64:
-		if d.FieldType.IsMultiValue() {
-			return fmt.Errorf("received unexpected %T for multi-value int", v)
+		if fieldType.IsMultiValue() {
+			return nil, fmt.Errorf("received unexpected %T for multi-value int", v)
 		} else {
-			d.DefaultValue = int64(math.Round(v))
-			return nil
+			return int64(math.Round(v)), nil
 		}
 	default:
-		return fmt.Errorf("received unexpected %T as for an integer default value", v)
+		return nil, fmt.Errorf("received unexpected %T as for an integer default value", v)
 	}
 }
 
@@ -0,0 +1,229 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// IncidentCustomFieldValue represents the value of a custom field on an incident.
+type IncidentCustomFieldValue struct {
+	ID          string                       `json:"id,omitempty"`
+	Name        string                       `json:"name,omitempty"`
+	DisplayName string                       `json:"display_name,omitempty"`
+	Type        string                       `json:"type,omitempty"`
+	Description *string                      `json:"description,omitempty"`
+	DataType    IncidentCustomFieldDataType  `json:"data_type,omitempty"`
+	FieldType   IncidentCustomFieldFieldType `json:"field_type,omitempty"`
+	Value       interface{}                  `json:"value"`
+}
+
+type rawIncidentCustomFieldValue IncidentCustomFieldValue
+
+func (d *IncidentCustomFieldValue) UnmarshalJSON(data []byte) error {
+	var p rawIncidentCustomFieldValue
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return err
+	}
+	*d = IncidentCustomFieldValue(p)
+	if p.Value != nil && p.DataType == IncidentCustomFieldDataTypeInt {
+		d.Value, err = convertIncidentCustomFieldInt(p.FieldType, p.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IncidentCustomFieldValuesPayload represents payload with the custom field values of an incident
+type IncidentCustomFieldValuesPayload struct {
+	CustomFields []*IncidentCustomFieldValue `json:"custom_fields"`
+}
+
+// writeIncidentCustomFieldValue is the subset of a value accepted when setting values.
+type writeIncidentCustomFieldValue struct {
+	ID    string      `json:"id,omitempty"`
+	Name  string      `json:"name,omitempty"`
+	Value interface{} `json:"value"`
+}
+
+type writeIncidentCustomFieldValuesPayload struct {
+	CustomFields []*writeIncidentCustomFieldValue `json:"custom_fields"`
+}
+
+// GetValuesContext gets the custom field values of an incident.
+func (s *IncidentCustomFieldService) GetValuesContext(ctx context.Context, incidentID string) ([]*IncidentCustomFieldValue, *Response, error) {
+	u := fmt.Sprintf("/incidents/%s/custom_fields/values", incidentID)
+	v := new(IncidentCustomFieldValuesPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CustomFields, resp, nil
+}
+
+// SetValuesContext sets custom field values on an incident. Each value identifies its field by ID or Name. The values are
+// validated against the field definitions, which are fetched first, and nothing is sent if any of them is invalid. A nil
+// Value clears the field.
+func (s *IncidentCustomFieldService) SetValuesContext(ctx context.Context, incidentID string, values []*IncidentCustomFieldValue) ([]*IncidentCustomFieldValue, *Response, error) {
+	fields, _, err := s.ListContext(ctx, &ListIncidentCustomFieldOptions{Includes: []string{"field_options"}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]*IncidentCustomField)
+	byName := make(map[string]*IncidentCustomField)
+	for _, f := range fields.Fields {
+		byID[f.ID] = f
+		byName[f.Name] = f
+	}
+
+	p := &writeIncidentCustomFieldValuesPayload{}
+	for _, cv := range values {
+		field, ok := byID[cv.ID]
+		if !ok {
+			field, ok = byName[cv.Name]
+		}
+		if !ok {
+			return nil, nil, fmt.Errorf("no custom field with ID %q or name %q can be found", cv.ID, cv.Name)
+		}
+		if err := field.ValidateValue(cv.Value); err != nil {
+			return nil, nil, err
+		}
+		p.CustomFields = append(p.CustomFields, &writeIncidentCustomFieldValue{ID: field.ID, Value: cv.Value})
+	}
+
+	u := fmt.Sprintf("/incidents/%s/custom_fields/values", incidentID)
+	v := new(IncidentCustomFieldValuesPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CustomFields, resp, nil
+}
+
+// ValidateValue checks that value can be set on the field. Multi-value fields take a slice, single-value fields a
+// scalar, every element must match the data type of the field and fixed fields only accept the values of their field
+// options. A nil value, which clears the field, is always valid.
+func (f *IncidentCustomField) ValidateValue(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	isSlice := rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array
+	if f.FieldType.IsMultiValue() != isSlice {
+		if isSlice {
+			return fmt.Errorf("custom field %q is %s and does not accept multiple values", f.Name, f.FieldType)
+		}
+		return fmt.Errorf("custom field %q is %s and requires a list of values", f.Name, f.FieldType)
+	}
+
+	elems := []interface{}{value}
+	if isSlice {
+		elems = make([]interface{}, rv.Len())
+		for i := range elems {
+			elems[i] = rv.Index(i).Interface()
+		}
+	}
+
+	fixed := f.FieldType == IncidentCustomFieldFieldTypeSingleValueFixed || f.FieldType == IncidentCustomFieldFieldTypeMultiValueFixed
+	for _, e := range elems {
+		if err := validateIncidentCustomFieldDataType(f.DataType, e); err != nil {
+			return fmt.Errorf("invalid value for custom field %q: %v", f.Name, err)
+		}
+		if fixed && !f.hasOptionValue(e) {
+			return fmt.Errorf("invalid value for custom field %q: %v is not one of its field options", f.Name, e)
+		}
+	}
+
+	return nil
+}
+
+func (f *IncidentCustomField) hasOptionValue(value interface{}) bool {
+	for _, o := range f.FieldOptions {
+		if o.Data == nil {
+			continue
+		}
+		if a, ok := customFieldNumber(value); ok {
+			if b, ok := customFieldNumber(o.Data.Value); ok && a == b {
+				return true
+			}
+			continue
+		}
+		if reflect.DeepEqual(o.Data.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateIncidentCustomFieldDataType(dataType IncidentCustomFieldDataType, value interface{}) error {
+	switch dataType {
+	case IncidentCustomFieldDataTypeString:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("expected a string, got %T", value)
+		}
+	case IncidentCustomFieldDataTypeUrl:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a URL string, got %T", value)
+		}
+		u, err := url.ParseRequestURI(s)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%q is not an absolute http(s) URL", s)
+		}
+	case IncidentCustomFieldDataTypeInt:
+		n, ok := customFieldNumber(value)
+		if !ok {
+			return fmt.Errorf("expected an integer, got %T", value)
+		}
+		if n != math.Trunc(n) {
+			return fmt.Errorf("expected an integer, got %v", value)
+		}
+	case IncidentCustomFieldDataTypeFloat:
+		if _, ok := customFieldNumber(value); !ok {
+			return fmt.Errorf("expected a number, got %T", value)
+		}
+	case IncidentCustomFieldDataTypeBool:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("expected a boolean, got %T", value)
+		}
+	case IncidentCustomFieldDataTypeDateTime:
+		switch v := value.(type) {
+		case time.Time:
+		case string:
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				return fmt.Errorf("%q is not an RFC 3339 datetime", v)
+			}
+		default:
+			return fmt.Errorf("expected an RFC 3339 datetime string, got %T", value)
+		}
+	}
+	return nil
+}
+
+// customFieldNumber returns value as a float64 if it holds any numeric type.
+func customFieldNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float32, float64:
+		return reflect.ValueOf(v).Float(), true
+	case int, int8, int16, int32, int64:
+		return float64(reflect.ValueOf(v).Int()), true
+	case uint, uint8, uint16, uint32, uint64:
+		return float64(reflect.ValueOf(v).Uint()), true
+	}
+	return 0, false
+}
@@ -0,0 +1,136 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIncidentCustomFieldGetValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/PINC1/custom_fields/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"custom_fields": [{"id": "F1", "name": "impact", "type": "field_value", "data_type": "integer", "field_type": "multi_value", "value": [1, 2]}, {"id": "F2", "name": "region", "type": "field_value", "data_type": "string", "field_type": "single_value_fixed", "value": "eu"}]}`))
+	})
+
+	resp, _, err := client.IncidentCustomFields.GetValuesContext(context.Background(), "PINC1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*IncidentCustomFieldValue{
+		{ID: "F1", Name: "impact", Type: "field_value", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeMultiValue, Value: []interface{}{int64(1), int64(2)}},
+		{ID: "F2", Name: "region", Type: "field_value", DataType: IncidentCustomFieldDataTypeString, FieldType: IncidentCustomFieldFieldTypeSingleValueFixed, Value: "eu"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestIncidentCustomFieldSetValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "include[]", "field_options")
+		w.Write([]byte(`{"fields": [{"id": "F1", "name": "impact", "data_type": "integer", "field_type": "multi_value"}, {"id": "F2", "name": "region", "data_type": "string", "field_type": "single_value_fixed", "field_options": [{"id": "O1", "data": {"data_type": "string", "value": "eu"}}]}]}`))
+	})
+	puts := 0
+	mux.HandleFunc("/incidents/PINC1/custom_fields/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		puts++
+		testBody(t, r, `{"custom_fields":[{"id":"F1","value":[1,2]},{"id":"F2","value":"eu"}]}`)
+		w.Write([]byte(`{"custom_fields": [{"id": "F2", "name": "region", "data_type": "string", "field_type": "single_value_fixed", "value": "eu"}]}`))
+	})
+
+	resp, _, err := client.IncidentCustomFields.SetValuesContext(context.Background(), "PINC1", []*IncidentCustomFieldValue{
+		{Name: "impact", Value: []int{1, 2}},
+		{ID: "F2", Value: "eu"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*IncidentCustomFieldValue{
+		{ID: "F2", Name: "region", DataType: IncidentCustomFieldDataTypeString, FieldType: IncidentCustomFieldFieldTypeSingleValueFixed, Value: "eu"},
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+
+	if _, _, err := client.IncidentCustomFields.SetValuesContext(context.Background(), "PINC1", []*IncidentCustomFieldValue{
+		{ID: "F2", Value: "us"},
+	}); err == nil {
+		t.Error("expected an error for a value outside of the field options")
+	}
+	if _, _, err := client.IncidentCustomFields.SetValuesContext(context.Background(), "PINC1", []*IncidentCustomFieldValue{
+		{Name: "missing", Value: "x"},
+	}); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+	if puts != 1 {
+		t.Errorf("sent %d updates; want 1", puts)
+	}
+}
+
+func TestIncidentCustomFieldValidateValue(t *testing.T) {
+	cases := []struct {
+		name  string
+		field *IncidentCustomField
+		value interface{}
+		err   string
+	}{
+		{"nil clears", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeSingleValue}, nil, ""},
+		{"int", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeSingleValue}, 3, ""},
+		{"integral float as int", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeSingleValue}, 3.0, ""},
+		{"fraction as int", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeSingleValue}, 3.5, "expected an integer"},
+		{"string as int", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeSingleValue}, "3", "expected an integer"},
+		{"float", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeFloat, FieldType: IncidentCustomFieldFieldTypeSingleValue}, 1.5, ""},
+		{"bool", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeBool, FieldType: IncidentCustomFieldFieldTypeSingleValue}, "true", "expected a boolean"},
+		{"url", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeUrl, FieldType: IncidentCustomFieldFieldTypeSingleValue}, "https://example.com/x", ""},
+		{"relative url", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeUrl, FieldType: IncidentCustomFieldFieldTypeSingleValue}, "/x", "not an absolute"},
+		{"datetime", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeDateTime, FieldType: IncidentCustomFieldFieldTypeSingleValue}, "2024-01-02T03:04:05Z", ""},
+		{"bad datetime", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeDateTime, FieldType: IncidentCustomFieldFieldTypeSingleValue}, "yesterday", "RFC 3339"},
+		{"list on single value", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeString, FieldType: IncidentCustomFieldFieldTypeSingleValue}, []string{"a"}, "does not accept multiple values"},
+		{"scalar on multi value", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeString, FieldType: IncidentCustomFieldFieldTypeMultiValue}, "a", "requires a list"},
+		{"bad element", &IncidentCustomField{Name: "f", DataType: IncidentCustomFieldDataTypeString, FieldType: IncidentCustomFieldFieldTypeMultiValue}, []interface{}{"a", 1}, "expected a string"},
+		{
+			"fixed int option",
+			&IncidentCustomField{
+				Name: "f", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeMultiValueFixed,
+				FieldOptions: []*IncidentCustomFieldOption{{Data: &IncidentCustomFieldOptionData{Value: float64(1)}}, {Data: &IncidentCustomFieldOptionData{Value: float64(2)}}},
+			},
+			[]int64{2, 1},
+			"",
+		},
+		{
+			"fixed int outside options",
+			&IncidentCustomField{
+				Name: "f", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeMultiValueFixed,
+				FieldOptions: []*IncidentCustomFieldOption{{Data: &IncidentCustomFieldOptionData{Value: float64(1)}}},
+			},
+			[]int64{3},
+			"not one of its field options",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.field.ValidateValue(c.value)
+			if c.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("returned error %v; want one containing %q", err, c.err)
+			}
+		})
+	}
+}
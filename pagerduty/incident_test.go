@@ -83,6 +83,7 @@ func TestIncidentsManage(t *testing.T) {
 
 	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "foo@example.com")
 		payload := &ManageIncidentsPayload{Incidents: input}
 		v := new(ManageIncidentsPayload)
 		json.NewDecoder(r.Body).Decode(v)
@@ -92,7 +93,7 @@ func TestIncidentsManage(t *testing.T) {
 		w.Write([]byte(`{"incidents": [{"id": "P1D3Z4B"}]}`))
 	})
 
-	resp, _, err := client.Incidents.ManageIncidents(input, &ManageIncidentsOptions{})
+	resp, _, err := client.Incidents.ManageIncidents(input, &ManageIncidentsOptions{From: "foo@example.com"})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -118,9 +119,11 @@ func TestIncidentsCreate(t *testing.T) {
 		Type:  "incident",
 		Title: "test incident",
 	}
+	client.Config.DefaultFrom = "foo@example.com"
 
 	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "foo@example.com")
 		payload := &IncidentPayload{Incident: input}
 		v := new(IncidentPayload)
 		json.NewDecoder(r.Body).Decode(v)
@@ -146,6 +149,45 @@ func TestIncidentsCreate(t *testing.T) {
 	}
 }
 
+func TestIncidentsCreateWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	client.Config.DefaultFrom = "default@example.com"
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "override@example.com")
+		w.Write([]byte(`{"incident": {"id": "1", "type": "incident"}}`))
+	})
+
+	resp, _, err := client.Incidents.CreateWithOptions(&Incident{Type: "incident"}, &CreateIncidentOptions{From: "override@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{
+		ID:   "1",
+		Type: "incident",
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsCreateWithoutFrom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent without a From header")
+	})
+
+	if _, _, err := client.Incidents.Create(&Incident{Type: "incident"}); err != ErrNoFrom {
+		t.Errorf("returned error %v; want %v", err, ErrNoFrom)
+	}
+}
+
 func TestIncidentsGet(t *testing.T) {
 	setup()
 	defer teardown()
@@ -170,3 +212,233 @@ func TestIncidentsGet(t *testing.T) {
 		t.Errorf("returned %#v; want %#v", resp, want)
 	}
 }
+
+func TestIncidentsMerge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "foo@example.com")
+		testBody(t, r, `{"source_incidents":[{"id":"2","type":"incident_reference"},{"id":"3","type":"incident_reference"}]}`)
+		w.Write([]byte(`{"incident": {"id": "1", "type": "incident", "title": "test incident"}}`))
+	})
+
+	resp, _, err := client.Incidents.Merge("1", "foo@example.com", []string{"2", "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{
+		ID:    "1",
+		Type:  "incident",
+		Title: "test incident",
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsSnooze(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/1/snooze", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "foo@example.com")
+		testBody(t, r, `{"duration":3600}`)
+		w.Write([]byte(`{"incident": {"id": "1", "status": "acknowledged"}}`))
+	})
+
+	resp, _, err := client.Incidents.Snooze("1", "foo@example.com", 3600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{
+		ID:     "1",
+		Status: "acknowledged",
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsReassignToUsers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "foo@example.com")
+		testBody(t, r, `{"incident":{"type":"incident_reference","assignments":[{"assignee":{"id":"PUSER1","type":"user_reference"}}]}}`)
+		w.Write([]byte(`{"incident": {"id": "1", "assignments": [{"at": "2023-01-01T00:00:00Z", "assignee": {"id": "PUSER1", "type": "user_reference"}}]}}`))
+	})
+
+	resp, _, err := client.Incidents.ReassignToUsers("1", "foo@example.com", []string{"PUSER1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{
+		ID: "1",
+		Assignments: []*IncidentAssignment{
+			{
+				At:       "2023-01-01T00:00:00Z",
+				Assignee: UserReference{ID: "PUSER1", Type: "user_reference"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsReassignToEscalationPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "foo@example.com")
+		testBody(t, r, `{"incident":{"type":"incident_reference","escalation_policy":{"id":"PEP1","type":"escalation_policy_reference"}}}`)
+		w.Write([]byte(`{"incident": {"id": "1", "escalation_policy": {"id": "PEP1", "type": "escalation_policy_reference"}}}`))
+	})
+
+	resp, _, err := client.Incidents.ReassignToEscalationPolicy("1", "foo@example.com", "PEP1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{
+		ID:               "1",
+		EscalationPolicy: &EscalationPolicyReference{ID: "PEP1", Type: "escalation_policy_reference"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsEscalate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "foo@example.com")
+		testBody(t, r, `{"incident":{"type":"incident_reference","escalation_level":2}}`)
+		w.Write([]byte(`{"incident": {"id": "1", "escalation_level": 2}}`))
+	})
+
+	resp, _, err := client.Incidents.Escalate("1", "foo@example.com", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Incident{
+		ID:              "1",
+		EscalationLevel: 2,
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	if _, _, err := client.Incidents.Escalate("1", "foo@example.com", 0); err == nil {
+		t.Error("expected an error escalating to level 0")
+	}
+}
+
+func TestIncidentsCreateResponderRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &ResponderRequest{
+		RequesterID: "PUSER1",
+		Message:     "please help",
+		ResponderRequestTargets: []*ResponderRequestTargetWrapper{
+			{Target: &ResponderRequestTarget{ID: "PUSER2", Type: "user_reference"}},
+		},
+	}
+
+	mux.HandleFunc("/incidents/1/responder_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "foo@example.com")
+		v := new(ResponderRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		w.Write([]byte(`{"responder_request": {
+			"incident": {"id": "1", "type": "incident_reference"},
+			"requester": {"id": "PUSER1", "type": "user_reference"},
+			"requested_at": "2023-01-01T00:00:00Z",
+			"message": "please help",
+			"responder_request_targets": [{
+				"responder_request_target": {
+					"id": "PUSER2",
+					"type": "user",
+					"incidents_responders": [{"state": "pending", "user": {"id": "PUSER2", "type": "user_reference"}}]
+				}
+			}]
+		}}`))
+	})
+
+	resp, _, err := client.Incidents.CreateResponderRequest("1", "foo@example.com", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ResponderRequest{
+		Incident:    &IncidentReference{ID: "1", Type: "incident_reference"},
+		Requester:   &UserReference{ID: "PUSER1", Type: "user_reference"},
+		RequestedAt: "2023-01-01T00:00:00Z",
+		Message:     "please help",
+		ResponderRequestTargets: []*ResponderRequestTargetWrapper{
+			{
+				Target: &ResponderRequestTarget{
+					ID:   "PUSER2",
+					Type: "user",
+					IncidentsResponders: []*IncidentResponders{
+						{State: "pending", User: &UserReference{ID: "PUSER2", Type: "user_reference"}},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentsCreateStatusUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incidents/1/status_updates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "foo@example.com")
+		testBody(t, r, `{"message":"still investigating"}`)
+		w.Write([]byte(`{"status_update": {"id": "PSU1", "message": "still investigating", "sender": {"id": "PUSER1", "type": "user_reference"}}}`))
+	})
+
+	resp, _, err := client.Incidents.CreateStatusUpdate("1", "foo@example.com", &IncidentStatusUpdate{Message: "still investigating"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &IncidentStatusUpdate{
+		ID:      "PSU1",
+		Message: "still investigating",
+		Sender:  &UserReference{ID: "PUSER1", Type: "user_reference"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
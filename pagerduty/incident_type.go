@@ -0,0 +1,194 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// IncidentTypeService handles the communication with incident type related methods of the PagerDuty API.
+type IncidentTypeService service
+
+// IncidentType represents an incident type.
+type IncidentType struct {
+	ID          string                 `json:"id,omitempty"`
+	Type        string                 `json:"type,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	DisplayName string                 `json:"display_name,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+	Parent      *IncidentTypeReference `json:"parent,omitempty"`
+	ParentType  string                 `json:"parent_type,omitempty"`
+	CreatedAt   string                 `json:"created_at,omitempty"`
+	UpdatedAt   string                 `json:"updated_at,omitempty"`
+}
+
+// IncidentTypePayload represents payload with an incident type object
+type IncidentTypePayload struct {
+	IncidentType *IncidentType `json:"incident_type,omitempty"`
+}
+
+// ListIncidentTypesResponse represents a list response of incident types
+type ListIncidentTypesResponse struct {
+	IncidentTypes []*IncidentType `json:"incident_types,omitempty"`
+}
+
+// ListIncidentTypesOptions represents options when retrieving a list of incident types.
+// Filter is one of "enabled", "disabled" or "all".
+type ListIncidentTypesOptions struct {
+	Filter string `url:"filter,omitempty"`
+}
+
+// ListContext lists existing incident types.
+func (s *IncidentTypeService) ListContext(ctx context.Context, o *ListIncidentTypesOptions) (*ListIncidentTypesResponse, *Response, error) {
+	u := "/incidents/types"
+	v := new(ListIncidentTypesResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetContext gets an incident type by its ID or name.
+func (s *IncidentTypeService) GetContext(ctx context.Context, typeIDOrName string) (*IncidentType, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s", typeIDOrName)
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
+
+// CreateContext creates a new incident type. ParentType must hold the ID or name of the type it derives from.
+func (s *IncidentTypeService) CreateContext(ctx context.Context, incidentType *IncidentType) (*IncidentType, *Response, error) {
+	u := "/incidents/types"
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentTypePayload{IncidentType: incidentType}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
+
+// UpdateContext updates an existing incident type identified by its ID or name.
+func (s *IncidentTypeService) UpdateContext(ctx context.Context, typeIDOrName string, incidentType *IncidentType) (*IncidentType, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s", typeIDOrName)
+	v := new(IncidentTypePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &IncidentTypePayload{IncidentType: incidentType}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentType, resp, nil
+}
+
+// ListCustomFieldsContext lists the custom fields of an incident type.
+func (s *IncidentTypeService) ListCustomFieldsContext(ctx context.Context, typeIDOrName string, o *ListIncidentCustomFieldOptions) (*ListIncidentCustomFieldResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields", typeIDOrName)
+	v := new(ListIncidentCustomFieldResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetCustomFieldContext gets a custom field of an incident type.
+func (s *IncidentTypeService) GetCustomFieldContext(ctx context.Context, typeIDOrName, fieldID string, o *GetIncidentCustomFieldOptions) (*IncidentCustomField, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields/%s", typeIDOrName, fieldID)
+	v := new(IncidentCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// CreateCustomFieldContext creates a new custom field on an incident type.
+func (s *IncidentTypeService) CreateCustomFieldContext(ctx context.Context, typeIDOrName string, field *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields", typeIDOrName)
+	v := new(IncidentCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentCustomFieldPayload{Field: field}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// UpdateCustomFieldContext updates an existing custom field of an incident type.
+func (s *IncidentTypeService) UpdateCustomFieldContext(ctx context.Context, typeIDOrName, fieldID string, field *IncidentCustomField) (*IncidentCustomField, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields/%s", typeIDOrName, fieldID)
+	v := new(IncidentCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &IncidentCustomFieldPayload{Field: field}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// DeleteCustomFieldContext removes an existing custom field of an incident type.
+func (s *IncidentTypeService) DeleteCustomFieldContext(ctx context.Context, typeIDOrName, fieldID string) (*Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields/%s", typeIDOrName, fieldID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// ListCustomFieldOptionsContext lists the field options of a custom field of an incident type.
+func (s *IncidentTypeService) ListCustomFieldOptionsContext(ctx context.Context, typeIDOrName, fieldID string) (*ListIncidentCustomFieldOptionsResponse, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields/%s/field_options", typeIDOrName, fieldID)
+	v := new(ListIncidentCustomFieldOptionsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// CreateCustomFieldOptionContext creates a new field option on a custom field of an incident type.
+func (s *IncidentTypeService) CreateCustomFieldOptionContext(ctx context.Context, typeIDOrName, fieldID string, fieldOption *IncidentCustomFieldOption) (*IncidentCustomFieldOption, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields/%s/field_options", typeIDOrName, fieldID)
+	v := new(IncidentCustomFieldOptionPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentCustomFieldOptionPayload{FieldOption: fieldOption}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.FieldOption, resp, nil
+}
+
+// UpdateCustomFieldOptionContext updates an existing field option on a custom field of an incident type.
+func (s *IncidentTypeService) UpdateCustomFieldOptionContext(ctx context.Context, typeIDOrName, fieldID, fieldOptionID string, fieldOption *IncidentCustomFieldOption) (*IncidentCustomFieldOption, *Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields/%s/field_options/%s", typeIDOrName, fieldID, fieldOptionID)
+	v := new(IncidentCustomFieldOptionPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &IncidentCustomFieldOptionPayload{FieldOption: fieldOption}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.FieldOption, resp, nil
+}
+
+// DeleteCustomFieldOptionContext removes an existing field option from a custom field of an incident type.
+func (s *IncidentTypeService) DeleteCustomFieldOptionContext(ctx context.Context, typeIDOrName, fieldID, fieldOptionID string) (*Response, error) {
+	u := fmt.Sprintf("/incidents/types/%s/custom_fields/%s/field_options/%s", typeIDOrName, fieldID, fieldOptionID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
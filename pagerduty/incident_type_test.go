@@ -0,0 +1,142 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentTypeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	enabled := true
+
+	mux.HandleFunc("/incidents/types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "filter", "all")
+		w.Write([]byte(`{"incident_types": [{"id": "PT1", "name": "security_incident", "display_name": "Security Incident", "enabled": true, "parent": {"id": "PT0", "type": "incident_type_reference"}}]}`))
+	})
+
+	resp, _, err := client.IncidentTypes.ListContext(context.Background(), &ListIncidentTypesOptions{Filter: "all"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListIncidentTypesResponse{
+		IncidentTypes: []*IncidentType{
+			{
+				ID:          "PT1",
+				Name:        "security_incident",
+				DisplayName: "Security Incident",
+				Enabled:     &enabled,
+				Parent:      &IncidentTypeReference{ID: "PT0", Type: "incident_type_reference"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestIncidentTypeCreateUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	disabled := false
+
+	mux.HandleFunc("/incidents/types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"incident_type":{"name":"security_incident","display_name":"Security Incident","parent_type":"base_incident"}}`)
+		w.Write([]byte(`{"incident_type": {"id": "PT1", "name": "security_incident", "display_name": "Security Incident"}}`))
+	})
+	mux.HandleFunc("/incidents/types/security_incident", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"incident_type":{"enabled":false}}`)
+		w.Write([]byte(`{"incident_type": {"id": "PT1", "name": "security_incident", "enabled": false}}`))
+	})
+
+	resp, _, err := client.IncidentTypes.CreateContext(context.Background(), &IncidentType{
+		Name:        "security_incident",
+		DisplayName: "Security Incident",
+		ParentType:  "base_incident",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&IncidentType{ID: "PT1", Name: "security_incident", DisplayName: "Security Incident"}); !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+
+	resp, _, err = client.IncidentTypes.UpdateContext(context.Background(), "security_incident", &IncidentType{Enabled: &disabled})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&IncidentType{ID: "PT1", Name: "security_incident", Enabled: &disabled}); !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestIncidentTypeCustomFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	enabled := true
+
+	mux.HandleFunc("/incidents/types/PT1/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testQueryValue(t, r, "include[]", "field_options")
+			w.Write([]byte(`{"fields": [{"id": "F1", "name": "impact", "data_type": "integer", "field_type": "single_value", "default_value": 3, "incident_type": "security_incident", "enabled": true}]}`))
+		case "POST":
+			testBody(t, r, `{"field":{"name":"impact","data_type":"integer","field_type":"single_value","default_value":3}}`)
+			w.Write([]byte(`{"field": {"id": "F1", "name": "impact", "data_type": "integer", "field_type": "single_value", "default_value": 3}}`))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/incidents/types/PT1/custom_fields/F1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	list, _, err := client.IncidentTypes.ListCustomFieldsContext(context.Background(), "PT1", &ListIncidentCustomFieldOptions{Includes: []string{"field_options"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ListIncidentCustomFieldResponse{
+		Fields: []*IncidentCustomField{
+			{
+				ID:           "F1",
+				Name:         "impact",
+				DataType:     IncidentCustomFieldDataTypeInt,
+				FieldType:    IncidentCustomFieldFieldTypeSingleValue,
+				DefaultValue: int64(3),
+				IncidentType: "security_incident",
+				Enabled:      &enabled,
+			},
+		},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", list, want)
+	}
+
+	field, _, err := client.IncidentTypes.CreateCustomFieldContext(context.Background(), "PT1", &IncidentCustomField{
+		Name:         "impact",
+		DataType:     IncidentCustomFieldDataTypeInt,
+		FieldType:    IncidentCustomFieldFieldTypeSingleValue,
+		DefaultValue: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if field.DefaultValue != int64(3) {
+		t.Errorf("returned default value %#v; want int64(3)", field.DefaultValue)
+	}
+
+	if _, err := client.IncidentTypes.DeleteCustomFieldContext(context.Background(), "PT1", "F1"); err != nil {
+		t.Fatal(err)
+	}
+}
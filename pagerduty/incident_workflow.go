@@ -75,6 +75,20 @@ type IncidentWorkflowPayload struct {
 	IncidentWorkflow *IncidentWorkflow `json:"incident_workflow,omitempty"`
 }
 
+// IncidentWorkflowInstance represents a run of an incident workflow on an
+// incident.
+type IncidentWorkflowInstance struct {
+	ID       string             `json:"id,omitempty"`
+	Type     string             `json:"type,omitempty"`
+	Incident *IncidentReference `json:"incident,omitempty"`
+}
+
+// IncidentWorkflowInstancePayload represents payload with an incident
+// workflow instance object.
+type IncidentWorkflowInstancePayload struct {
+	IncidentWorkflowInstance *IncidentWorkflowInstance `json:"incident_workflow_instance,omitempty"`
+}
+
 // ListIncidentWorkflowOptions represents options when retrieving a list of incident workflows.
 type ListIncidentWorkflowOptions struct {
 	Offset   int      `url:"offset,omitempty"`
@@ -220,3 +234,27 @@ func (s *IncidentWorkflowService) UpdateContext(ctx context.Context, id string,
 
 	return v.IncidentWorkflow, resp, nil
 }
+
+// StartInstance starts a new instance of an incident workflow on an incident.
+func (s *IncidentWorkflowService) StartInstance(id, incidentID string) (*IncidentWorkflowInstance, *Response, error) {
+	return s.StartInstanceContext(context.Background(), id, incidentID)
+}
+
+// StartInstanceContext starts a new instance of an incident workflow on an
+// incident.
+func (s *IncidentWorkflowService) StartInstanceContext(ctx context.Context, id, incidentID string) (*IncidentWorkflowInstance, *Response, error) {
+	u := fmt.Sprintf("/incident_workflows/%s/instances", id)
+	v := new(IncidentWorkflowInstancePayload)
+	p := &IncidentWorkflowInstancePayload{
+		IncidentWorkflowInstance: &IncidentWorkflowInstance{
+			Incident: &IncidentReference{ID: incidentID, Type: "incident_reference"},
+		},
+	}
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, p, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.IncidentWorkflowInstance, resp, nil
+}
@@ -0,0 +1,203 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// IncidentWorkflowActionService handles the communication with the incident
+// workflow action catalog of the PagerDuty API.
+type IncidentWorkflowActionService service
+
+// IncidentWorkflowAction represents an action that can be used as a step of
+// an incident workflow.
+type IncidentWorkflowAction struct {
+	ID            string                          `json:"id,omitempty"`
+	Type          string                          `json:"type,omitempty"`
+	Name          string                          `json:"name,omitempty"`
+	Description   string                          `json:"description,omitempty"`
+	Domain        string                          `json:"domain,omitempty"`
+	ActionVersion string                          `json:"action_version,omitempty"`
+	Metadata      *IncidentWorkflowActionMetadata `json:"metadata,omitempty"`
+	CreatedAt     string                          `json:"created_at,omitempty"`
+	LastUpdatedAt string                          `json:"last_updated_at,omitempty"`
+}
+
+// IncidentWorkflowActionMetadata represents the input and output schemas of
+// an incident workflow action.
+type IncidentWorkflowActionMetadata struct {
+	Inputs  []*IncidentWorkflowActionParameter `json:"inputs,omitempty"`
+	Outputs []*IncidentWorkflowActionParameter `json:"outputs,omitempty"`
+}
+
+// IncidentWorkflowActionParameter represents the definition of an input or
+// output of an incident workflow action.
+type IncidentWorkflowActionParameter struct {
+	Name          string `json:"name,omitempty"`
+	ParameterType string `json:"parameter_type,omitempty"`
+	DisplayName   string `json:"display_name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	DefaultValue  string `json:"default_value,omitempty"`
+	IsRequired    bool   `json:"is_required,omitempty"`
+}
+
+// IncidentWorkflowActionPayload represents payload with an incident workflow
+// action object.
+type IncidentWorkflowActionPayload struct {
+	Action *IncidentWorkflowAction `json:"action,omitempty"`
+}
+
+// ListIncidentWorkflowActionsResponse represents a list response of incident
+// workflow actions.
+type ListIncidentWorkflowActionsResponse struct {
+	Actions    []*IncidentWorkflowAction `json:"actions,omitempty"`
+	NextCursor string                    `json:"next_cursor,omitempty"`
+	Limit      int                       `json:"limit,omitempty"`
+}
+
+// ListIncidentWorkflowActionsOptions represents options when listing
+// incident workflow actions.
+type ListIncidentWorkflowActionsOptions struct {
+	Limit   int    `url:"limit,omitempty"`
+	Cursor  string `url:"cursor,omitempty"`
+	Keyword string `url:"keyword,omitempty"`
+}
+
+type listIncidentWorkflowActionsOptionsGen struct {
+	options *ListIncidentWorkflowActionsOptions
+}
+
+func (o *listIncidentWorkflowActionsOptionsGen) currentCursor() string {
+	return o.options.Cursor
+}
+
+func (o *listIncidentWorkflowActionsOptionsGen) changeCursor(s string) {
+	o.options.Cursor = s
+}
+
+func (o *listIncidentWorkflowActionsOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// List lists the incident workflow actions. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire catalog of actions will be returned.
+func (s *IncidentWorkflowActionService) List(o *ListIncidentWorkflowActionsOptions) (*ListIncidentWorkflowActionsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists the incident workflow actions. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire catalog of actions will be returned.
+func (s *IncidentWorkflowActionService) ListContext(ctx context.Context, o *ListIncidentWorkflowActionsOptions) (*ListIncidentWorkflowActionsResponse, *Response, error) {
+	u := "/incident_workflows/actions"
+	v := new(ListIncidentWorkflowActionsResponse)
+
+	if o == nil {
+		o = &ListIncidentWorkflowActionsOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	actions := make([]*IncidentWorkflowAction, 0)
+
+	// Create a handler closure capable of parsing data from the actions
+	// endpoint and appending resultant actions to the return slice.
+	responseHandler := func(response *Response) (CursorListResp, *Response, error) {
+		var result ListIncidentWorkflowActionsResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return CursorListResp{}, response, err
+		}
+
+		actions = append(actions, result.Actions...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return CursorListResp{
+			Limit:      result.Limit,
+			NextCursor: result.NextCursor,
+		}, response, nil
+	}
+	err := s.client.newRequestCursorPagedGetQueryDoContext(ctx, u, responseHandler, &listIncidentWorkflowActionsOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Actions = actions
+
+	return v, nil, nil
+}
+
+// Get gets an incident workflow action.
+func (s *IncidentWorkflowActionService) Get(id string) (*IncidentWorkflowAction, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext gets an incident workflow action.
+func (s *IncidentWorkflowActionService) GetContext(ctx context.Context, id string) (*IncidentWorkflowAction, *Response, error) {
+	u := fmt.Sprintf("/incident_workflows/actions/%s", id)
+	v := new(IncidentWorkflowActionPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Action, resp, nil
+}
+
+// ValidateConfiguration checks an incident workflow step configuration
+// against the input schema of the action. It reports configurations for a
+// different action, inputs the action does not define and required inputs
+// without a value or a default.
+func (a *IncidentWorkflowAction) ValidateConfiguration(c *IncidentWorkflowActionConfiguration) error {
+	if c == nil {
+		return fmt.Errorf("no configuration provided for action %q", a.ID)
+	}
+	if c.ActionID != a.ID {
+		return fmt.Errorf("configuration is for action %q, not %q", c.ActionID, a.ID)
+	}
+
+	defined := make(map[string]*IncidentWorkflowActionParameter)
+	if a.Metadata != nil {
+		for _, p := range a.Metadata.Inputs {
+			defined[p.Name] = p
+		}
+	}
+
+	var problems []string
+	provided := make(map[string]bool)
+	for _, in := range c.Inputs {
+		provided[in.Name] = true
+		if _, ok := defined[in.Name]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown input %q", in.Name))
+		}
+	}
+	for _, in := range c.InlineStepsInputs {
+		provided[in.Name] = true
+		if _, ok := defined[in.Name]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown input %q", in.Name))
+		}
+	}
+	if a.Metadata != nil {
+		for _, p := range a.Metadata.Inputs {
+			if p.IsRequired && p.DefaultValue == "" && !provided[p.Name] {
+				problems = append(problems, fmt.Sprintf("missing required input %q", p.Name))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration for action %q: %s", a.ID, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIncidentWorkflowActionsList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incident_workflows/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"actions": [{"id": "pagerduty.com:incident-workflows:send-status-update:1", "type": "action", "name": "Send Status Update"}], "limit": 1, "next_cursor": "abc"}`))
+		case "abc":
+			w.Write([]byte(`{"actions": [{"id": "pagerduty.com:incident-workflows:add-note:1", "type": "action", "name": "Add Note"}], "limit": 1}`))
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	resp, _, err := client.IncidentWorkflowActions.List(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListIncidentWorkflowActionsResponse{
+		Actions: []*IncidentWorkflowAction{
+			{ID: "pagerduty.com:incident-workflows:send-status-update:1", Type: "action", Name: "Send Status Update"},
+			{ID: "pagerduty.com:incident-workflows:add-note:1", Type: "action", Name: "Add Note"},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentWorkflowActionsGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incident_workflows/actions/pagerduty.com:incident-workflows:add-note:1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"action": {"id": "pagerduty.com:incident-workflows:add-note:1", "type": "action", "name": "Add Note", "domain": "pagerduty.com", "action_version": "1", "metadata": {"inputs": [{"name": "Note", "parameter_type": "text", "is_required": true}]}}}`))
+	})
+
+	resp, _, err := client.IncidentWorkflowActions.Get("pagerduty.com:incident-workflows:add-note:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &IncidentWorkflowAction{
+		ID:            "pagerduty.com:incident-workflows:add-note:1",
+		Type:          "action",
+		Name:          "Add Note",
+		Domain:        "pagerduty.com",
+		ActionVersion: "1",
+		Metadata: &IncidentWorkflowActionMetadata{
+			Inputs: []*IncidentWorkflowActionParameter{{Name: "Note", ParameterType: "text", IsRequired: true}},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestIncidentWorkflowActionValidateConfiguration(t *testing.T) {
+	action := &IncidentWorkflowAction{
+		ID: "example/action/v1",
+		Metadata: &IncidentWorkflowActionMetadata{
+			Inputs: []*IncidentWorkflowActionParameter{
+				{Name: "Message", IsRequired: true},
+				{Name: "Priority", IsRequired: true, DefaultValue: "P3"},
+				{Name: "Steps"},
+			},
+		},
+	}
+
+	valid := &IncidentWorkflowActionConfiguration{
+		ActionID: "example/action/v1",
+		Inputs:   []*IncidentWorkflowActionInput{{Name: "Message", Value: "hello"}},
+		InlineStepsInputs: []*IncidentWorkflowActionInlineStepsInput{
+			{Name: "Steps", Value: &IncidentWorkflowActionInlineStepsInputValue{}},
+		},
+	}
+	if err := action.ValidateConfiguration(valid); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	invalid := &IncidentWorkflowActionConfiguration{
+		ActionID: "example/action/v1",
+		Inputs:   []*IncidentWorkflowActionInput{{Name: "Mesage", Value: "hello"}},
+	}
+	err := action.ValidateConfiguration(invalid)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, want := range []string{`unknown input "Mesage"`, `missing required input "Message"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+
+	if err := action.ValidateConfiguration(&IncidentWorkflowActionConfiguration{ActionID: "other/action/v1"}); err == nil {
+		t.Error("expected an error for a configuration of another action")
+	}
+}
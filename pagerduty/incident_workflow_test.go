@@ -813,3 +813,29 @@ func TestIncidentWorkflowUpdate(t *testing.T) {
 		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
 	}
 }
+
+func TestIncidentWorkflowStartInstance(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/incident_workflows/IW1/instances", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"incident_workflow_instance":{"incident":{"id":"PINC1","type":"incident_reference"}}}`)
+		w.Write([]byte(`{"incident_workflow_instance": {"id": "IWI1", "type": "incident_workflow_instance", "incident": {"id": "PINC1", "type": "incident_reference"}}}`))
+	})
+
+	resp, _, err := client.IncidentWorkflows.StartInstance("IW1", "PINC1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &IncidentWorkflowInstance{
+		ID:       "IWI1",
+		Type:     "incident_workflow_instance",
+		Incident: &IncidentReference{ID: "PINC1", Type: "incident_reference"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
@@ -0,0 +1,437 @@
+package pagerduty
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// Results of evaluating an email against an email integration.
+const (
+	EmailActionTrigger = "trigger"
+	EmailActionResolve = "resolve"
+	EmailActionDiscard = "discard"
+)
+
+// EmailMessage is the part of an email that email integrations look at.
+type EmailMessage struct {
+	Subject       string
+	Body          string
+	FromAddresses []string
+}
+
+// ParseEmailMessage reads a raw RFC 822 message. The body is the first
+// text/plain part of a multipart message, or the first text/html one if
+// there is none, decoded from its transfer encoding.
+func ParseEmailMessage(r io.Reader) (*EmailMessage, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &EmailMessage{}
+	dec := new(mime.WordDecoder)
+	if m.Subject, err = dec.DecodeHeader(msg.Header.Get("Subject")); err != nil {
+		m.Subject = msg.Header.Get("Subject")
+	}
+
+	if from := msg.Header.Get("From"); from != "" {
+		addrs, err := mail.ParseAddressList(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid From header %q: %v", from, err)
+		}
+		for _, a := range addrs {
+			m.FromAddresses = append(m.FromAddresses, a.Address)
+		}
+	}
+
+	body, err := emailBody(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.Body = body
+
+	return m, nil
+}
+
+func emailBody(contentType, encoding string, r io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if contentType == "" || err != nil {
+		mediaType = "text/plain"
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		data, err := ioutil.ReadAll(decodeEmailTransfer(encoding, r))
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+
+	var html string
+	mr := multipart.NewReader(r, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return html, nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		partType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if part.Header.Get("Content-Type") == "" || err != nil {
+			partType = "text/plain"
+		}
+		if !strings.HasPrefix(partType, "multipart/") && partType != "text/plain" && (partType != "text/html" || html != "") {
+			continue
+		}
+
+		// multipart.Reader already decodes quoted-printable parts.
+		body, err := emailBody(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case partType == "text/html":
+			html = body
+		case body != "":
+			return body, nil
+		}
+	}
+}
+
+func decodeEmailTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	}
+	return r
+}
+
+// part returns the text of a part of the message: subject, body or
+// from_addresses, the latter joined by commas.
+func (m *EmailMessage) part(name string) (string, error) {
+	switch name {
+	case "subject":
+		return m.Subject, nil
+	case "body":
+		return m.Body, nil
+	case "from_addresses":
+		return strings.Join(m.FromAddresses, ", "), nil
+	}
+	return "", fmt.Errorf("unknown email part %q", name)
+}
+
+// EmailEvaluation is the outcome of evaluating an email against an email
+// integration.
+type EmailEvaluation struct {
+	// Accepted reports whether the email filters let the email through.
+	// AcceptedBy holds the indexes of the filters the email matched.
+	Accepted   bool
+	AcceptedBy []int
+
+	// Parser is the first email parser whose predicate matched, and
+	// ParserIndex its index, or -1 when none did or parsers are not used.
+	Parser      *EmailParser
+	ParserIndex int
+
+	// Values holds the values extracted by the parser, by value name.
+	Values map[string]string
+
+	// Action is trigger, resolve or discard.
+	Action string
+}
+
+// EvaluateEmail parses a raw RFC 822 message and evaluates it against the
+// email filters and parsers of an integration.
+func (i *Integration) EvaluateEmail(r io.Reader) (*EmailEvaluation, error) {
+	m, err := ParseEmailMessage(r)
+	if err != nil {
+		return nil, err
+	}
+	return i.EvaluateEmailMessage(m)
+}
+
+// EvaluateEmailMessage evaluates a parsed message against the email filters
+// and parsers of an integration. Parsers are only used when incidents are
+// created using rules; the action of the first matching one applies, or the
+// parsing fallback when none matches. Email is matched case-sensitively.
+func (i *Integration) EvaluateEmailMessage(m *EmailMessage) (*EmailEvaluation, error) {
+	if errs := i.ValidateEmailRegexes(); len(errs) > 0 {
+		return nil, errs[0]
+	}
+	e := &EmailEvaluation{ParserIndex: -1, Values: make(map[string]string)}
+
+	for n, f := range i.EmailFilters {
+		ok, err := f.accepts(m)
+		if err != nil {
+			return nil, fmt.Errorf("email_filters[%d]: %v", n, err)
+		}
+		if ok {
+			e.AcceptedBy = append(e.AcceptedBy, n)
+		}
+	}
+	switch i.EmailFilterMode {
+	case "", "all-email":
+		e.Accepted = true
+	case "or-rules-email":
+		e.Accepted = len(e.AcceptedBy) > 0
+	case "and-rules-email":
+		e.Accepted = len(e.AcceptedBy) == len(i.EmailFilters)
+	default:
+		return nil, fmt.Errorf("unknown email filter mode %q", i.EmailFilterMode)
+	}
+	if !e.Accepted {
+		e.Action = EmailActionDiscard
+		return e, nil
+	}
+
+	if i.EmailIncidentCreation != "use_rules" {
+		e.Action = EmailActionTrigger
+		return e, nil
+	}
+
+	for n, p := range i.EmailParsers {
+		ok, err := p.MatchPredicate.matches(m)
+		if err != nil {
+			return nil, fmt.Errorf("email_parsers[%d]: %v", n, err)
+		}
+		if !ok {
+			continue
+		}
+		e.Parser, e.ParserIndex = p, n
+		for _, x := range p.ValueExtractors {
+			value, ok, err := x.extract(m)
+			if err != nil {
+				return nil, fmt.Errorf("email_parsers[%d]: %v", n, err)
+			}
+			if ok {
+				e.Values[x.ValueName] = value
+			}
+		}
+		switch p.Action {
+		case EmailActionTrigger, EmailActionResolve:
+			e.Action = p.Action
+		default:
+			return nil, fmt.Errorf("email_parsers[%d]: unknown action %q", n, p.Action)
+		}
+		return e, nil
+	}
+
+	switch i.EmailParsingFallback {
+	case "", "open_new_incident":
+		e.Action = EmailActionTrigger
+	case "discard":
+		e.Action = EmailActionDiscard
+	default:
+		return nil, fmt.Errorf("unknown email parsing fallback %q", i.EmailParsingFallback)
+	}
+	return e, nil
+}
+
+func (f *EmailFilter) accepts(m *EmailMessage) (bool, error) {
+	// The from filter accepts the email if any of its senders matches.
+	from := m.FromAddresses
+	if len(from) == 0 {
+		from = []string{""}
+	}
+	fromOK := false
+	for _, addr := range from {
+		ok, err := emailFilterMatches("from_email", f.FromEmailMode, f.FromEmailRegex, addr)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			fromOK = true
+			break
+		}
+	}
+	if !fromOK {
+		return false, nil
+	}
+
+	ok, err := emailFilterMatches("subject", f.SubjectMode, f.SubjectRegex, m.Subject)
+	if err != nil || !ok {
+		return false, err
+	}
+	return emailFilterMatches("body", f.BodyMode, f.BodyRegex, m.Body)
+}
+
+func emailFilterMatches(name, mode, regex, text string) (bool, error) {
+	switch mode {
+	case "", "always":
+		return true, nil
+	case "match", "no-match":
+		re, err := regexp.Compile(regex)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(text) == (mode == "match"), nil
+	}
+	return false, fmt.Errorf("unknown %s_mode %q", name, mode)
+}
+
+func (p *MatchPredicate) matches(m *EmailMessage) (bool, error) {
+	if p == nil {
+		return false, nil
+	}
+	return matchEmailPredicates(p.Type, p.Predicates, m)
+}
+
+// matchEmailPredicates combines predicates with "all" or "any".
+func matchEmailPredicates(kind string, predicates []*Predicate, m *EmailMessage) (bool, error) {
+	for _, p := range predicates {
+		ok, err := p.matches(m)
+		if err != nil {
+			return false, err
+		}
+		switch kind {
+		case "all":
+			if !ok {
+				return false, nil
+			}
+		case "any":
+			if ok {
+				return true, nil
+			}
+		default:
+			return false, fmt.Errorf("unknown predicate type %q", kind)
+		}
+	}
+	return kind == "all", nil
+}
+
+func (p *Predicate) matches(m *EmailMessage) (bool, error) {
+	switch p.Type {
+	case "not":
+		ok, err := matchEmailPredicates("any", p.Predicates, m)
+		return !ok, err
+	case "all", "any":
+		return matchEmailPredicates(p.Type, p.Predicates, m)
+	}
+
+	text, err := m.part(p.Part)
+	if err != nil {
+		return false, err
+	}
+	switch p.Type {
+	case "contains":
+		return strings.Contains(text, p.Matcher), nil
+	case "exactly":
+		return text == p.Matcher, nil
+	case "regex":
+		re, err := regexp.Compile(p.Matcher)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(text), nil
+	}
+	return false, fmt.Errorf("unknown predicate type %q", p.Type)
+}
+
+// extract returns the value the extractor finds in the message. A regex
+// extractor returns its first capture group, or the whole match if it has
+// none.
+func (x *ValueExtractor) extract(m *EmailMessage) (string, bool, error) {
+	text, err := m.part(x.Part)
+	if err != nil {
+		return "", false, err
+	}
+
+	switch x.Type {
+	case "entire":
+		return text, true, nil
+	case "regex":
+		re, err := regexp.Compile(x.Regex)
+		if err != nil {
+			return "", false, err
+		}
+		match := re.FindStringSubmatch(text)
+		if match == nil {
+			return "", false, nil
+		}
+		if len(match) > 1 {
+			return match[1], true, nil
+		}
+		return match[0], true, nil
+	case "between":
+		start := strings.Index(text, x.StartsAfter)
+		if start < 0 {
+			return "", false, nil
+		}
+		rest := text[start+len(x.StartsAfter):]
+		if x.EndsBefore == "" {
+			return rest, true, nil
+		}
+		end := strings.Index(rest, x.EndsBefore)
+		if end < 0 {
+			return "", false, nil
+		}
+		return rest[:end], true, nil
+	}
+	return "", false, fmt.Errorf("unknown value extractor type %q", x.Type)
+}
+
+// EmailRegexError reports an invalid regex in the email configuration of an
+// integration. Field locates it, as in email_filters[0].subject_regex.
+type EmailRegexError struct {
+	Field string
+	Regex string
+	Err   error
+}
+
+func (e *EmailRegexError) Error() string {
+	return fmt.Sprintf("%s: invalid regex %q: %v", e.Field, e.Regex, e.Err)
+}
+
+// ValidateEmailRegexes checks every regex of the email filters, match
+// predicates and value extractors of an integration. Regexes of filters
+// whose mode does not use them are checked too.
+func (i *Integration) ValidateEmailRegexes() []*EmailRegexError {
+	var errs []*EmailRegexError
+	check := func(field, regex string) {
+		if _, err := regexp.Compile(regex); err != nil {
+			errs = append(errs, &EmailRegexError{Field: field, Regex: regex, Err: err})
+		}
+	}
+
+	for n, f := range i.EmailFilters {
+		check(fmt.Sprintf("email_filters[%d].subject_regex", n), f.SubjectRegex)
+		check(fmt.Sprintf("email_filters[%d].body_regex", n), f.BodyRegex)
+		check(fmt.Sprintf("email_filters[%d].from_email_regex", n), f.FromEmailRegex)
+	}
+
+	var walk func(field string, predicates []*Predicate)
+	walk = func(field string, predicates []*Predicate) {
+		for n, p := range predicates {
+			f := fmt.Sprintf("%s.children[%d]", field, n)
+			if p.Type == "regex" {
+				check(f+".matcher", p.Matcher)
+			}
+			walk(f, p.Predicates)
+		}
+	}
+	for n, p := range i.EmailParsers {
+		field := fmt.Sprintf("email_parsers[%d]", n)
+		if p.MatchPredicate != nil {
+			walk(field+".match_predicate", p.MatchPredicate.Predicates)
+		}
+		for k, x := range p.ValueExtractors {
+			if x.Type == "regex" {
+				check(fmt.Sprintf("%s.value_extractors[%d].regex", field, k), x.Regex)
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,176 @@
+package pagerduty
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testEmail = "From: Monitoring <alerts@example.com>\r\n" +
+	"To: service@example.pagerduty.com\r\n" +
+	"Subject: =?UTF-8?Q?CRITICAL:_web-1_down?=\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/alternative; boundary=\"b1\"\r\n" +
+	"\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/html\r\n" +
+	"\r\n" +
+	"<p>html</p>\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"Host: web-1\r\n" +
+	"Key: [ABC-123] st=\r\n" +
+	"atus=3Ddown\r\n" +
+	"--b1--\r\n"
+
+func testEmailIntegration() *Integration {
+	return &Integration{
+		EmailIncidentCreation: "use_rules",
+		EmailFilterMode:       "or-rules-email",
+		EmailFilters: []*EmailFilter{
+			{SubjectMode: "match", SubjectRegex: "^CRITICAL", BodyMode: "always", FromEmailMode: "always"},
+			{SubjectMode: "always", BodyMode: "no-match", BodyRegex: "test", FromEmailMode: "match", FromEmailRegex: "@example\\.com$"},
+		},
+		EmailParsers: []*EmailParser{
+			{
+				Action: EmailActionResolve,
+				MatchPredicate: &MatchPredicate{Type: "all", Predicates: []*Predicate{
+					{Type: "contains", Part: "subject", Matcher: "RECOVERY"},
+				}},
+			},
+			{
+				Action: EmailActionTrigger,
+				MatchPredicate: &MatchPredicate{Type: "all", Predicates: []*Predicate{
+					{Type: "regex", Part: "subject", Matcher: "down$"},
+					{Type: "not", Predicates: []*Predicate{
+						{Type: "exactly", Part: "from_addresses", Matcher: "noise@example.com"},
+					}},
+				}},
+				ValueExtractors: []*ValueExtractor{
+					{Type: "regex", Part: "body", ValueName: "incident_key", Regex: `\[([A-Z]+-\d+)\]`},
+					{Type: "between", Part: "body", ValueName: "host", StartsAfter: "Host: ", EndsBefore: "\r\n"},
+					{Type: "entire", Part: "subject", ValueName: "summary"},
+					{Type: "regex", Part: "body", ValueName: "missing", Regex: "nope"},
+				},
+			},
+		},
+		EmailParsingFallback: "discard",
+	}
+}
+
+func TestParseEmailMessage(t *testing.T) {
+	m, err := ParseEmailMessage(strings.NewReader(testEmail))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &EmailMessage{
+		Subject:       "CRITICAL: web-1 down",
+		Body:          "Host: web-1\r\nKey: [ABC-123] status=down",
+		FromAddresses: []string{"alerts@example.com"},
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("returned %#v; want %#v", m, want)
+	}
+}
+
+func TestParseEmailMessageBase64(t *testing.T) {
+	raw := "From: alerts@example.com\r\n" +
+		"Subject: test\r\n" +
+		"Content-Type: text/plain\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"\r\n" +
+		"SG9zdDogd2Vi\r\n" +
+		"LTEgZG93bg==\r\n"
+	m, err := ParseEmailMessage(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Host: web-1 down"; m.Body != want {
+		t.Errorf("body %q; want %q", m.Body, want)
+	}
+}
+
+func TestIntegrationEvaluateEmail(t *testing.T) {
+	i := testEmailIntegration()
+
+	e, err := i.EvaluateEmail(strings.NewReader(testEmail))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &EmailEvaluation{
+		Accepted:    true,
+		AcceptedBy:  []int{0, 1},
+		Parser:      i.EmailParsers[1],
+		ParserIndex: 1,
+		Values: map[string]string{
+			"incident_key": "ABC-123",
+			"host":         "web-1",
+			"summary":      "CRITICAL: web-1 down",
+		},
+		Action: EmailActionTrigger,
+	}
+	if !reflect.DeepEqual(e, want) {
+		t.Errorf("returned %#v; want %#v", e, want)
+	}
+}
+
+func TestIntegrationEvaluateEmailMessage(t *testing.T) {
+	cases := []struct {
+		name   string
+		update func(*Integration)
+		msg    *EmailMessage
+		want   string
+		parser int
+	}{
+		{"resolve", nil, &EmailMessage{Subject: "RECOVERY: web-1 up", FromAddresses: []string{"a@example.com"}}, EmailActionResolve, 0},
+		{"fallback discard", nil, &EmailMessage{Subject: "web-1 slow", FromAddresses: []string{"a@example.com"}}, EmailActionDiscard, -1},
+		{"fallback trigger", func(i *Integration) { i.EmailParsingFallback = "open_new_incident" }, &EmailMessage{Subject: "web-1 slow", FromAddresses: []string{"a@example.com"}}, EmailActionTrigger, -1},
+		{"not predicate", nil, &EmailMessage{Subject: "web-1 down", FromAddresses: []string{"noise@example.com"}}, EmailActionDiscard, -1},
+		{"filtered", nil, &EmailMessage{Subject: "web-1 down", Body: "test", FromAddresses: []string{"a@example.org"}}, EmailActionDiscard, -1},
+		{"and filters", func(i *Integration) { i.EmailFilterMode = "and-rules-email" }, &EmailMessage{Subject: "web-1 down", FromAddresses: []string{"a@example.com"}}, EmailActionDiscard, -1},
+		{"all email", func(i *Integration) { i.EmailFilterMode = "all-email" }, &EmailMessage{Subject: "RECOVERY", FromAddresses: []string{"a@example.org"}}, EmailActionResolve, 0},
+		{"no rules", func(i *Integration) { i.EmailIncidentCreation = "on_new_email" }, &EmailMessage{Subject: "RECOVERY", FromAddresses: []string{"a@example.com"}}, EmailActionTrigger, -1},
+	}
+
+	for _, c := range cases {
+		i := testEmailIntegration()
+		if c.update != nil {
+			c.update(i)
+		}
+		e, err := i.EvaluateEmailMessage(c.msg)
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if e.Action != c.want || e.ParserIndex != c.parser {
+			t.Errorf("%s: action %s with parser %d; want %s with parser %d", c.name, e.Action, e.ParserIndex, c.want, c.parser)
+		}
+	}
+}
+
+func TestIntegrationValidateEmailRegexes(t *testing.T) {
+	i := testEmailIntegration()
+	i.EmailFilters[0].BodyRegex = "("
+	i.EmailParsers[1].MatchPredicate.Predicates[1].Predicates = append(i.EmailParsers[1].MatchPredicate.Predicates[1].Predicates,
+		&Predicate{Type: "regex", Part: "body", Matcher: "[a-"})
+	i.EmailParsers[1].ValueExtractors[0].Regex = "a)"
+
+	var fields []string
+	for _, err := range i.ValidateEmailRegexes() {
+		fields = append(fields, err.Field)
+	}
+	want := []string{
+		"email_filters[0].body_regex",
+		"email_parsers[1].match_predicate.children[1].children[1].matcher",
+		"email_parsers[1].value_extractors[0].regex",
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("invalid regexes %v; want %v", fields, want)
+	}
+
+	if _, err := i.EvaluateEmailMessage(&EmailMessage{}); err == nil {
+		t.Error("expected an error evaluating with invalid regexes")
+	}
+}
@@ -0,0 +1,298 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// logEntryMaxOffset is the deepest offset the log entries endpoint will
+	// page to before it rejects the request.
+	logEntryMaxOffset = 10000
+
+	logEntryPageLimit     = 100
+	defaultLogEntryWindow = 24 * time.Hour
+	minLogEntryWindow     = time.Minute
+)
+
+// LogEntryService handles the communication with log entry
+// related methods of the PagerDuty API.
+type LogEntryService service
+
+// LogEntry represents a log entry of an incident.
+type LogEntry struct {
+	ID           string             `json:"id,omitempty"`
+	Type         string             `json:"type,omitempty"`
+	Summary      string             `json:"summary,omitempty"`
+	Self         string             `json:"self,omitempty"`
+	HTMLURL      string             `json:"html_url,omitempty"`
+	CreatedAt    string             `json:"created_at,omitempty"`
+	Agent        *AgentReference    `json:"agent,omitempty"`
+	Channel      *LogEntryChannel   `json:"channel,omitempty"`
+	Service      *ServiceReference  `json:"service,omitempty"`
+	Incident     *IncidentReference `json:"incident,omitempty"`
+	Teams        []*TeamReference   `json:"teams,omitempty"`
+	Contexts     []*LogEntryContext `json:"contexts,omitempty"`
+	User         *UserReference     `json:"user,omitempty"`
+	Assignees    []*UserReference   `json:"assignees,omitempty"`
+	Note         string             `json:"note,omitempty"`
+	EventDetails map[string]string  `json:"event_details,omitempty"`
+}
+
+// LogEntryChannel represents the channel through which a log entry was created.
+type LogEntryChannel struct {
+	Type    string      `json:"type,omitempty"`
+	Summary string      `json:"summary,omitempty"`
+	Subject string      `json:"subject,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// LogEntryContext represents a link or image attached to a log entry.
+type LogEntryContext struct {
+	Type string `json:"type,omitempty"`
+	Href string `json:"href,omitempty"`
+	Text string `json:"text,omitempty"`
+	Src  string `json:"src,omitempty"`
+	Alt  string `json:"alt,omitempty"`
+}
+
+// ListLogEntriesOptions represents options when listing log entries.
+type ListLogEntriesOptions struct {
+	Limit      int      `url:"limit,omitempty"`
+	Offset     int      `url:"offset,omitempty"`
+	Total      bool     `url:"total,omitempty"`
+	TimeZone   string   `url:"time_zone,omitempty"`
+	Since      string   `url:"since,omitempty"`
+	Until      string   `url:"until,omitempty"`
+	IsOverview bool     `url:"is_overview,omitempty"`
+	Include    []string `url:"include,omitempty,brackets"`
+	TeamIDs    []string `url:"team_ids,omitempty,brackets"`
+}
+
+// GetLogEntryOptions represents options when retrieving a log entry.
+type GetLogEntryOptions struct {
+	TimeZone string   `url:"time_zone,omitempty"`
+	Include  []string `url:"include,omitempty,brackets"`
+}
+
+// ListLogEntriesResponse represents a list response of log entries.
+type ListLogEntriesResponse struct {
+	Limit      int         `json:"limit,omitempty"`
+	More       bool        `json:"more,omitempty"`
+	Offset     int         `json:"offset,omitempty"`
+	Total      int         `json:"total,omitempty"`
+	LogEntries []*LogEntry `json:"log_entries,omitempty"`
+}
+
+// LogEntryPayload represents a log entry.
+type LogEntryPayload struct {
+	LogEntry *LogEntry `json:"log_entry,omitempty"`
+}
+
+// List lists a single page of log entries.
+func (s *LogEntryService) List(o *ListLogEntriesOptions) (*ListLogEntriesResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists a single page of log entries.
+func (s *LogEntryService) ListContext(ctx context.Context, o *ListLogEntriesOptions) (*ListLogEntriesResponse, *Response, error) {
+	u := "/log_entries"
+	v := new(ListLogEntriesResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Get retrieves information about a log entry.
+func (s *LogEntryService) Get(id string, o *GetLogEntryOptions) (*LogEntry, *Response, error) {
+	return s.GetContext(context.Background(), id, o)
+}
+
+// GetContext retrieves information about a log entry.
+func (s *LogEntryService) GetContext(ctx context.Context, id string, o *GetLogEntryOptions) (*LogEntry, *Response, error) {
+	u := fmt.Sprintf("/log_entries/%s", id)
+	v := new(LogEntryPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.LogEntry, resp, nil
+}
+
+// IterateLogEntriesOptions represents options when iterating over log
+// entries. Window is the width of the time windows the range between Since
+// and Until is split into and defaults to 24 hours.
+type IterateLogEntriesOptions struct {
+	Since      time.Time
+	Until      time.Time
+	Window     time.Duration
+	TimeZone   string
+	IsOverview bool
+	Include    []string
+	TeamIDs    []string
+}
+
+// LogEntryIterator streams log entries over a time range. The range is
+// walked from Until back to Since one time window at a time, so entries are
+// returned newest first like the API does. Windows holding more entries than
+// the API can page through are split until they fit.
+type LogEntryIterator struct {
+	service *LogEntryService
+	ctx     context.Context
+	options *IterateLogEntriesOptions
+
+	cursor      time.Time
+	windowStart time.Time
+	windowEnd   time.Time
+	nextOffset  int
+	more        bool
+
+	buf      []*LogEntry
+	seen     map[string]bool
+	lastSeen map[string]bool
+	current  *LogEntry
+	err      error
+}
+
+// Iterate returns an iterator over the log entries between o.Since and o.Until.
+func (s *LogEntryService) Iterate(o *IterateLogEntriesOptions) *LogEntryIterator {
+	return s.IterateContext(context.Background(), o)
+}
+
+// IterateContext returns an iterator over the log entries between o.Since and o.Until.
+func (s *LogEntryService) IterateContext(ctx context.Context, o *IterateLogEntriesOptions) *LogEntryIterator {
+	it := &LogEntryIterator{
+		service: s,
+		ctx:     ctx,
+		options: o,
+	}
+
+	switch {
+	case o == nil || o.Since.IsZero() || o.Until.IsZero():
+		it.err = fmt.Errorf("both since and until are required to iterate log entries")
+	case !o.Since.Before(o.Until):
+		it.err = fmt.Errorf("since (%s) must be before until (%s)", o.Since.Format(time.RFC3339), o.Until.Format(time.RFC3339))
+	default:
+		it.cursor = o.Until
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next log entry, fetching further pages
+// and time windows as needed. It returns false once all entries have been
+// read or an error occurred.
+func (it *LogEntryIterator) Next() bool {
+	for it.err == nil {
+		if len(it.buf) > 0 {
+			it.current, it.buf = it.buf[0], it.buf[1:]
+			if it.lastSeen[it.current.ID] {
+				continue
+			}
+			it.seen[it.current.ID] = true
+			return true
+		}
+
+		if it.more {
+			it.fetchPage()
+			continue
+		}
+
+		if !it.cursor.After(it.options.Since) {
+			break
+		}
+		it.nextWindow()
+	}
+
+	it.current = nil
+	return false
+}
+
+// LogEntry returns the log entry the iterator currently points to.
+func (it *LogEntryIterator) LogEntry() *LogEntry {
+	return it.current
+}
+
+// Err returns the first error encountered while iterating.
+func (it *LogEntryIterator) Err() error {
+	return it.err
+}
+
+// nextWindow moves to the window ending at the cursor and loads its first
+// page, halving the window while it holds more entries than can be paged.
+func (it *LogEntryIterator) nextWindow() {
+	window := it.options.Window
+	if window <= 0 {
+		window = defaultLogEntryWindow
+	}
+
+	end := it.cursor
+	start := end.Add(-window)
+	if start.Before(it.options.Since) {
+		start = it.options.Since
+	}
+
+	for {
+		resp, err := it.list(start, end, 0, true)
+		if err != nil {
+			it.err = err
+			return
+		}
+
+		if resp.Total > logEntryMaxOffset && end.Sub(start) > minLogEntryWindow {
+			start = end.Add(-end.Sub(start) / 2)
+			continue
+		}
+
+		// Entries sitting exactly on the boundary between two windows can be
+		// returned for both of them, so skip the ones already yielded.
+		it.lastSeen, it.seen = it.seen, make(map[string]bool)
+		it.windowStart, it.windowEnd, it.cursor = start, end, start
+		it.consume(resp)
+		return
+	}
+}
+
+func (it *LogEntryIterator) fetchPage() {
+	if it.nextOffset >= logEntryMaxOffset {
+		it.err = fmt.Errorf("log entries between %s and %s exceed the maximum offset of %d", it.windowStart.Format(time.RFC3339), it.windowEnd.Format(time.RFC3339), logEntryMaxOffset)
+		return
+	}
+
+	resp, err := it.list(it.windowStart, it.windowEnd, it.nextOffset, false)
+	if err != nil {
+		it.err = err
+		return
+	}
+	it.consume(resp)
+}
+
+func (it *LogEntryIterator) consume(resp *ListLogEntriesResponse) {
+	it.buf = append(it.buf, resp.LogEntries...)
+	it.more = resp.More && len(resp.LogEntries) > 0
+	it.nextOffset = resp.Offset + len(resp.LogEntries)
+}
+
+func (it *LogEntryIterator) list(since, until time.Time, offset int, total bool) (*ListLogEntriesResponse, error) {
+	o := &ListLogEntriesOptions{
+		Limit:      logEntryPageLimit,
+		Offset:     offset,
+		Total:      total,
+		TimeZone:   it.options.TimeZone,
+		Since:      since.Format(time.RFC3339),
+		Until:      until.Format(time.RFC3339),
+		IsOverview: it.options.IsOverview,
+		Include:    it.options.Include,
+		TeamIDs:    it.options.TeamIDs,
+	}
+
+	resp, _, err := it.service.ListContext(it.ctx, o)
+	return resp, err
+}
@@ -0,0 +1,180 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLogEntriesList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/log_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "since", "2023-01-01T00:00:00Z")
+		testQueryValue(t, r, "is_overview", "true")
+		testQueryValue(t, r, "team_ids[]", "PTEAM1")
+		w.Write([]byte(`{"log_entries": [{"id": "PLOG1", "type": "trigger_log_entry", "agent": {"id": "PSVC1", "type": "service_reference"}, "channel": {"type": "api"}}], "limit": 25, "more": false}`))
+	})
+
+	resp, _, err := client.LogEntries.List(&ListLogEntriesOptions{
+		Since:      "2023-01-01T00:00:00Z",
+		IsOverview: true,
+		TeamIDs:    []string{"PTEAM1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListLogEntriesResponse{
+		Limit: 25,
+		LogEntries: []*LogEntry{
+			{
+				ID:      "PLOG1",
+				Type:    "trigger_log_entry",
+				Agent:   &AgentReference{ID: "PSVC1", Type: "service_reference"},
+				Channel: &LogEntryChannel{Type: "api"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestLogEntriesGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/log_entries/PLOG1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "include[]", "incidents")
+		w.Write([]byte(`{"log_entry": {"id": "PLOG1", "type": "annotate_log_entry", "note": "looking", "incident": {"id": "PINC1", "type": "incident_reference"}}}`))
+	})
+
+	resp, _, err := client.LogEntries.Get("PLOG1", &GetLogEntryOptions{Include: []string{"incidents"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &LogEntry{
+		ID:       "PLOG1",
+		Type:     "annotate_log_entry",
+		Note:     "looking",
+		Incident: &IncidentReference{ID: "PINC1", Type: "incident_reference"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestLogEntriesIterate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(48 * time.Hour)
+
+	var windows []string
+	mux.HandleFunc("/log_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		q := r.URL.Query()
+		window := q.Get("since") + "/" + q.Get("until")
+
+		switch {
+		case window == "2023-01-02T00:00:00Z/2023-01-03T00:00:00Z" && q.Get("offset") == "":
+			windows = append(windows, window)
+			w.Write([]byte(`{"log_entries": [{"id": "4"}, {"id": "3"}], "offset": 0, "limit": 100, "more": true, "total": 3}`))
+		case window == "2023-01-02T00:00:00Z/2023-01-03T00:00:00Z" && q.Get("offset") == "2":
+			w.Write([]byte(`{"log_entries": [{"id": "2"}], "offset": 2, "limit": 100, "more": false}`))
+		case window == "2023-01-01T00:00:00Z/2023-01-02T00:00:00Z":
+			windows = append(windows, window)
+			// The entry on the boundary is returned for both windows.
+			w.Write([]byte(`{"log_entries": [{"id": "2"}, {"id": "1"}], "offset": 0, "limit": 100, "more": false, "total": 2}`))
+		default:
+			t.Errorf("unexpected request for window %s at offset %q", window, q.Get("offset"))
+			w.Write([]byte(`{"log_entries": []}`))
+		}
+	})
+
+	it := client.LogEntries.Iterate(&IterateLogEntriesOptions{Since: since, Until: until})
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.LogEntry().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"4", "3", "2", "1"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("returned %v; want %v", ids, want)
+	}
+
+	wantWindows := []string{
+		"2023-01-02T00:00:00Z/2023-01-03T00:00:00Z",
+		"2023-01-01T00:00:00Z/2023-01-02T00:00:00Z",
+	}
+	if !reflect.DeepEqual(windows, wantWindows) {
+		t.Errorf("requested windows %v; want %v", windows, wantWindows)
+	}
+}
+
+func TestLogEntriesIterateSplitsLargeWindows(t *testing.T) {
+	setup()
+	defer teardown()
+
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(2 * time.Hour)
+
+	var windows []string
+	mux.HandleFunc("/log_entries", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		window := q.Get("since") + "/" + q.Get("until")
+		windows = append(windows, window)
+
+		total := 5
+		if window == "2023-01-01T00:00:00Z/2023-01-01T02:00:00Z" {
+			total = logEntryMaxOffset + 1
+		}
+		w.Write([]byte(fmt.Sprintf(`{"log_entries": [{"id": "%s"}], "offset": 0, "limit": 100, "more": false, "total": %d}`, q.Get("since"), total)))
+	})
+
+	it := client.LogEntries.Iterate(&IterateLogEntriesOptions{Since: since, Until: until, Window: 2 * time.Hour})
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.LogEntry().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"2023-01-01T01:00:00Z", "2023-01-01T00:00:00Z"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("returned %v; want %v", ids, want)
+	}
+
+	wantWindows := []string{
+		"2023-01-01T00:00:00Z/2023-01-01T02:00:00Z",
+		"2023-01-01T01:00:00Z/2023-01-01T02:00:00Z",
+		"2023-01-01T00:00:00Z/2023-01-01T01:00:00Z",
+	}
+	if !reflect.DeepEqual(windows, wantWindows) {
+		t.Errorf("requested windows %v; want %v", windows, wantWindows)
+	}
+}
+
+func TestLogEntriesIterateRequiresRange(t *testing.T) {
+	it := (&LogEntryService{}).Iterate(&IterateLogEntriesOptions{Since: time.Now()})
+	if it.Next() {
+		t.Error("expected iterator without until to stop")
+	}
+	if it.Err() == nil {
+		t.Error("expected an error without until")
+	}
+}
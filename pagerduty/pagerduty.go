@@ -51,7 +51,11 @@ type service struct {
 	client *Client
 }
 
-// Config represents the configuration for a PagerDuty client
+// Config represents the configuration for a PagerDuty client.
+//
+// DefaultFrom is the requester email sent in the From header of requests
+// that must be attributed to a user, such as incident creation and updates,
+// when a call does not provide one.
 type Config struct {
 	BaseURL                   string
 	HTTPClient                *http.Client
@@ -60,6 +64,7 @@ type Config struct {
 	Debug                     bool
 	APIAuthTokenType          *AuthTokenType
 	AppOauthScopedTokenParams *persistentconfig.AppOauthScopedTokenParams
+	DefaultFrom               string
 	clientPersistentConfig    *persistentconfig.ClientPersistentConfig
 }
 
@@ -100,10 +105,21 @@ type Client struct {
 	Incidents                        *IncidentService
 	IncidentWorkflows                *IncidentWorkflowService
 	IncidentWorkflowTriggers         *IncidentWorkflowTriggerService
+	IncidentWorkflowActions          *IncidentWorkflowActionService
 	CustomFields                     *CustomFieldService
 	CustomFieldSchemas               *CustomFieldSchemaService
 	CustomFieldSchemaAssignments     *CustomFieldSchemaAssignmentService
 	IncidentCustomFields             *IncidentCustomFieldService
+	LogEntries                       *LogEntryService
+	AuditRecords                     *AuditRecordService
+	Analytics                        *AnalyticsService
+	StatusDashboards                 *StatusDashboardService
+	StatusPages                      *StatusPageService
+	Standards                        *StandardService
+	Templates                        *TemplateService
+	AlertGroupingSettings            *AlertGroupingSettingService
+	IncidentTypes                    *IncidentTypeService
+	ServiceCustomFields              *ServiceCustomFieldService
 }
 
 // Response is a wrapper around http.Response
@@ -192,10 +208,21 @@ func NewClient(config *Config) (*Client, error) {
 	c.Incidents = &IncidentService{c}
 	c.IncidentWorkflows = &IncidentWorkflowService{c}
 	c.IncidentWorkflowTriggers = &IncidentWorkflowTriggerService{c}
+	c.IncidentWorkflowActions = &IncidentWorkflowActionService{c}
 	c.CustomFields = &CustomFieldService{c}
 	c.CustomFieldSchemas = &CustomFieldSchemaService{c}
 	c.CustomFieldSchemaAssignments = &CustomFieldSchemaAssignmentService{c}
 	c.IncidentCustomFields = &IncidentCustomFieldService{c}
+	c.LogEntries = &LogEntryService{c}
+	c.AuditRecords = &AuditRecordService{c}
+	c.Analytics = &AnalyticsService{c}
+	c.StatusDashboards = &StatusDashboardService{c}
+	c.StatusPages = &StatusPageService{c}
+	c.Standards = &StandardService{c}
+	c.Templates = &TemplateService{c}
+	c.AlertGroupingSettings = &AlertGroupingSettingService{c}
+	c.IncidentTypes = &IncidentTypeService{c}
+	c.ServiceCustomFields = &ServiceCustomFieldService{c}
 
 	InitCache(c)
 	PopulateCache()
@@ -372,7 +399,7 @@ func (c *Client) newRequestDoOptionsContext(ctx context.Context, method, url str
 	resp, err := c.do(req, v)
 	if err != nil {
 		if respErr, ok := err.(*Error); ok && respErr.needToRetry {
-			return c.newRequestDoOptionsContext(ctx, method, url, nil, body, v)
+			return c.newRequestDoOptionsContext(ctx, method, url, nil, body, v, reqOptions...)
 		}
 
 		return nil, err
@@ -381,6 +408,24 @@ func (c *Client) newRequestDoOptionsContext(ctx context.Context, method, url str
 	return resp, nil
 }
 
+// fromHeader builds the From header for requests that must be attributed to
+// a user. An empty from falls back to Config.DefaultFrom and ErrNoFrom is
+// returned when neither is set.
+func (c *Client) fromHeader(from string) (RequestOptions, error) {
+	if from == "" {
+		from = c.Config.DefaultFrom
+	}
+	if from == "" {
+		return RequestOptions{}, ErrNoFrom
+	}
+
+	return RequestOptions{
+		Type:  "header",
+		Label: "From",
+		Value: from,
+	}, nil
+}
+
 func (c *Client) do(req *http.Request, v interface{}) (*Response, error) {
 	sLogger := newSecureLogger()
 	sLogger.LogReq(req)
@@ -58,6 +58,13 @@ type RulesetReference resourceReference
 // SubscriberReference represents a reference to a subscriber schema
 type SubscriberReference resourceReference
 
+// IncidentReference represents a reference to an incident.
+type IncidentReference resourceReference
+
+// AgentReference represents a reference to the user, service or
+// integration that performed an action.
+type AgentReference resourceReference
+
 // IncidentAttributeReference represents a reference to a Incident
 // Attribute schema
 type IncidentAttributeReference resourceReference
@@ -65,3 +72,32 @@ type IncidentAttributeReference resourceReference
 // CustomFieldSchemaReference represents a reference to a Custom
 // Field schema
 type CustomFieldSchemaReference resourceReference
+
+// BusinessServiceReference represents a reference to a business service.
+type BusinessServiceReference resourceReference
+
+// StatusPageReference represents a reference to a status page.
+type StatusPageReference resourceReference
+
+// StatusPagePostReference represents a reference to a status page post.
+type StatusPagePostReference resourceReference
+
+// StatusPageStatusReference represents a reference to a status page status.
+type StatusPageStatusReference resourceReference
+
+// StatusPageSeverityReference represents a reference to a status page
+// severity.
+type StatusPageSeverityReference resourceReference
+
+// StatusPageComponentReference represents a reference to a status page
+// component.
+type StatusPageComponentReference resourceReference
+
+// IncidentTypeReference represents a reference to an incident type.
+type IncidentTypeReference resourceReference
+
+// PriorityReference represents a reference to a priority along with its order.
+type PriorityReference struct {
+	ID    string `json:"id,omitempty"`
+	Order int    `json:"order,omitempty"`
+}
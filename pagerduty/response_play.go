@@ -62,10 +62,9 @@ func (s *ResponsePlayService) List(o *ListResponsePlayOptions) (*ListResponsePla
 	u := "/response_plays"
 	v := new(ListResponsePlaysResponse)
 
-	ro := RequestOptions{
-		Type:  "header",
-		Label: "from",
-		Value: o.From,
+	ro, err := s.client.fromHeader(o.From)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	responsePlays := make([]*ResponsePlay, 0)
@@ -89,7 +88,7 @@ func (s *ResponsePlayService) List(o *ListResponsePlayOptions) (*ListResponsePla
 			Limit:  result.Limit,
 		}, response, nil
 	}
-	err := s.client.newRequestPagedGetDo(u, responseHandler, ro)
+	err = s.client.newRequestPagedGetDo(u, responseHandler, ro)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,10 +102,9 @@ func (s *ResponsePlayService) Create(responsePlay *ResponsePlay) (*ResponsePlay,
 	u := "/response_plays"
 	v := new(ResponsePlayPayload)
 	p := &ResponsePlayPayload{ResponsePlay: responsePlay}
-	o := RequestOptions{
-		Type:  "header",
-		Label: "from",
-		Value: responsePlay.FromEmail,
+	o, err := s.client.fromHeader(responsePlay.FromEmail)
+	if err != nil {
+		return nil, nil, err
 	}
 	resp, err := s.client.newRequestDoOptions("POST", u, nil, p, v, o)
 	if err != nil {
@@ -124,10 +122,9 @@ func (s *ResponsePlayService) Get(ID, From string) (*ResponsePlay, *Response, er
 	u := fmt.Sprintf("/response_plays/%s", ID)
 	v := new(ResponsePlayPayload)
 	p := &ResponsePlayPayload{}
-	o := RequestOptions{
-		Type:  "header",
-		Label: "from",
-		Value: From,
+	o, err := s.client.fromHeader(From)
+	if err != nil {
+		return nil, nil, err
 	}
 	resp, err := s.client.newRequestDoOptions("GET", u, nil, p, v, o)
 	if err != nil {
@@ -143,10 +140,9 @@ func (s *ResponsePlayService) Get(ID, From string) (*ResponsePlay, *Response, er
 // Delete deletes an existing response_play.
 func (s *ResponsePlayService) Delete(ID, From string) (*Response, error) {
 	u := fmt.Sprintf("/response_plays/%s", ID)
-	o := RequestOptions{
-		Type:  "header",
-		Label: "from",
-		Value: From,
+	o, err := s.client.fromHeader(From)
+	if err != nil {
+		return nil, err
 	}
 	return s.client.newRequestDoOptions("DELETE", u, nil, nil, nil, o)
 }
@@ -156,10 +152,9 @@ func (s *ResponsePlayService) Update(ID string, responsePlay *ResponsePlay) (*Re
 	u := fmt.Sprintf("/response_plays/%s", ID)
 	v := new(ResponsePlayPayload)
 	p := ResponsePlayPayload{ResponsePlay: responsePlay}
-	o := RequestOptions{
-		Type:  "header",
-		Label: "from",
-		Value: responsePlay.FromEmail,
+	o, err := s.client.fromHeader(responsePlay.FromEmail)
+	if err != nil {
+		return nil, nil, err
 	}
 	resp, err := s.client.newRequestDoOptions("PUT", u, nil, p, v, o)
 	if err != nil {
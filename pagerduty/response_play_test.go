@@ -45,9 +45,11 @@ func TestResponsePlayCreate(t *testing.T) {
 	setup()
 	defer teardown()
 	input := &ResponsePlay{Name: "foo"}
+	client.Config.DefaultFrom = "foo@email.com"
 
 	mux.HandleFunc("/response_plays", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
+		testHeader(t, r, "From", "foo@email.com")
 		v := new(ResponsePlay)
 		v.Name = "foo"
 		json.NewDecoder(r.Body).Decode(v)
@@ -106,9 +108,11 @@ func TestResponsePlayUpdate(t *testing.T) {
 	input := &ResponsePlay{
 		Name: "foo",
 	}
+	client.Config.DefaultFrom = "foo@email.com"
 
 	mux.HandleFunc("/response_plays/1", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PUT")
+		testHeader(t, r, "From", "foo@email.com")
 		v := new(ResponsePlay)
 		v.Name = "foo"
 
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -252,13 +253,47 @@ func (s *RulesetService) ListRules(rulesetID string) (*ListRulesetRulesResponse,
 	return v, resp, nil
 }
 
+// ListAllRulesContext lists every event rule of a ruleset, following pages,
+// in the order they are evaluated.
+func (s *RulesetService) ListAllRulesContext(ctx context.Context, rulesetID string) ([]*RulesetRule, error) {
+	u := fmt.Sprintf("/rulesets/%s/rules", rulesetID)
+	rules := make([]*RulesetRule, 0)
+
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListRulesetRulesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		rules = append(rules, result.Rules...)
+
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &simpleOffsetQueryOptionsGen{})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
 // CreateRule for Ruleset
 func (s *RulesetService) CreateRule(rulesetID string, rule *RulesetRule) (*RulesetRule, *Response, error) {
+	return s.CreateRuleContext(context.Background(), rulesetID, rule)
+}
+
+// CreateRuleContext for Ruleset
+func (s *RulesetService) CreateRuleContext(ctx context.Context, rulesetID string, rule *RulesetRule) (*RulesetRule, *Response, error) {
 	u := fmt.Sprintf("/rulesets/%s/rules", rulesetID)
 	v := new(RulesetRulePayload)
 	p := RulesetRulePayload{Rule: rule}
 
-	resp, err := s.client.newRequestDo("POST", u, nil, p, &v)
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, p, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -281,11 +316,16 @@ func (s *RulesetService) GetRule(rulesetID, ruleID string) (*RulesetRule, *Respo
 
 // UpdateRule for Ruleset
 func (s *RulesetService) UpdateRule(rulesetID, ruleID string, rule *RulesetRule) (*RulesetRule, *Response, error) {
+	return s.UpdateRuleContext(context.Background(), rulesetID, ruleID, rule)
+}
+
+// UpdateRuleContext for Ruleset
+func (s *RulesetService) UpdateRuleContext(ctx context.Context, rulesetID, ruleID string, rule *RulesetRule) (*RulesetRule, *Response, error) {
 	u := fmt.Sprintf("/rulesets/%s/rules/%s", rulesetID, ruleID)
 	v := new(RulesetRulePayload)
 	p := RulesetRulePayload{Rule: rule}
 
-	resp, err := s.client.newRequestDo("PUT", u, nil, p, &v)
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, p, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -295,6 +335,11 @@ func (s *RulesetService) UpdateRule(rulesetID, ruleID string, rule *RulesetRule)
 
 // DeleteRule deletes an existing rule from the ruleset.
 func (s *RulesetService) DeleteRule(rulesetID, ruleID string) (*Response, error) {
+	return s.DeleteRuleContext(context.Background(), rulesetID, ruleID)
+}
+
+// DeleteRuleContext deletes an existing rule from the ruleset.
+func (s *RulesetService) DeleteRuleContext(ctx context.Context, rulesetID, ruleID string) (*Response, error) {
 	u := fmt.Sprintf("/rulesets/%s/rules/%s", rulesetID, ruleID)
-	return s.client.newRequestDo("DELETE", u, nil, nil, nil)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
 }
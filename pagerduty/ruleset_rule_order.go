@@ -0,0 +1,393 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Kinds of edits to the rules of a ruleset.
+const (
+	RulesetRuleEditInsert  = "insert"
+	RulesetRuleEditMove    = "move"
+	RulesetRuleEditDisable = "disable"
+	RulesetRuleEditEnable  = "enable"
+	RulesetRuleEditDelete  = "delete"
+)
+
+// RulesetRuleEdit is an edit to the rules of a ruleset. Insert edits add
+// Rule at Position and move edits move the rule with RuleID there, Position
+// being an index among the rules that are not the catch-all one. The other
+// edits only use RuleID.
+type RulesetRuleEdit struct {
+	Type     string
+	RuleID   string
+	Rule     *RulesetRule
+	Position int
+}
+
+// ApplyRulesetRuleEdits applies edits in order to a list of rules and
+// returns the resulting list, leaving the given one and its rules unchanged.
+// The catch-all rule stays last: it can be disabled or enabled but not moved
+// or deleted, and no catch-all rule can be inserted.
+func ApplyRulesetRuleEdits(rules []*RulesetRule, edits []*RulesetRuleEdit) ([]*RulesetRule, error) {
+	var result []*RulesetRule
+	var catchAll *RulesetRule
+	for _, r := range rules {
+		if r.CatchAll {
+			catchAll = r
+			continue
+		}
+		result = append(result, r)
+	}
+
+	find := func(id string) (int, error) {
+		if catchAll != nil && catchAll.ID == id {
+			return -1, nil
+		}
+		for i, r := range result {
+			if r.ID != "" && r.ID == id {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("no rule %s", id)
+	}
+	checkPosition := func(pos, max int) error {
+		if pos < 0 || pos > max {
+			return fmt.Errorf("position %d is out of range [0, %d]", pos, max)
+		}
+		return nil
+	}
+
+	for n, e := range edits {
+		if e.Type == RulesetRuleEditInsert {
+			if e.Rule == nil {
+				return nil, fmt.Errorf("edit %d: insert without a rule", n)
+			}
+			if e.Rule.CatchAll {
+				return nil, fmt.Errorf("edit %d: cannot insert a catch-all rule", n)
+			}
+			if err := checkPosition(e.Position, len(result)); err != nil {
+				return nil, fmt.Errorf("edit %d: %v", n, err)
+			}
+			result = append(result[:e.Position], append([]*RulesetRule{e.Rule}, result[e.Position:]...)...)
+			continue
+		}
+
+		i, err := find(e.RuleID)
+		if err != nil {
+			return nil, fmt.Errorf("edit %d: %v", n, err)
+		}
+
+		switch e.Type {
+		case RulesetRuleEditMove, RulesetRuleEditDelete:
+			if i < 0 {
+				return nil, fmt.Errorf("edit %d: cannot %s the catch-all rule", n, e.Type)
+			}
+			r := result[i]
+			result = append(result[:i:i], result[i+1:]...)
+			if e.Type == RulesetRuleEditDelete {
+				continue
+			}
+			if err := checkPosition(e.Position, len(result)); err != nil {
+				return nil, fmt.Errorf("edit %d: %v", n, err)
+			}
+			result = append(result[:e.Position], append([]*RulesetRule{r}, result[e.Position:]...)...)
+		case RulesetRuleEditDisable, RulesetRuleEditEnable:
+			r := catchAll
+			if i >= 0 {
+				r = result[i]
+			}
+			c := *r
+			c.Disabled = e.Type == RulesetRuleEditDisable
+			if i < 0 {
+				catchAll = &c
+			} else {
+				result[i] = &c
+			}
+		default:
+			return nil, fmt.Errorf("edit %d: unknown type %q", n, e.Type)
+		}
+	}
+
+	if catchAll != nil {
+		result = append(result, catchAll)
+	}
+	return result, nil
+}
+
+// Kinds of calls a ruleset rule change makes.
+const (
+	RulesetRuleChangeCreate = "create"
+	RulesetRuleChangeUpdate = "update"
+	RulesetRuleChangeDelete = "delete"
+)
+
+// RulesetRuleChange is a call to CreateRule, UpdateRule or DeleteRule. Rule
+// is the body sent, with Position set when the rule is placed. Index is the
+// index of the rule in the desired list, or -1 for deletions.
+type RulesetRuleChange struct {
+	Type   string
+	RuleID string
+	Rule   *RulesetRule
+	Index  int
+}
+
+func (c *RulesetRuleChange) String() string {
+	switch {
+	case c.Type == RulesetRuleChangeDelete:
+		return fmt.Sprintf("delete %s", c.RuleID)
+	case c.Rule.Position != nil && c.Type == RulesetRuleChangeCreate:
+		return fmt.Sprintf("create at %d", *c.Rule.Position)
+	case c.Rule.Position != nil:
+		return fmt.Sprintf("update %s at %d", c.RuleID, *c.Rule.Position)
+	}
+	return fmt.Sprintf("%s %s", c.Type, c.RuleID)
+}
+
+// PlanRulesetRuleOrder returns the calls turning the current rules of a
+// ruleset into the desired ones. Rules of the desired list without an ID are
+// created, current rules missing from it are deleted and the others are
+// updated when they moved or changed.
+//
+// Placing a rule shifts the following ones, so the rules in the longest run
+// already in the desired order are left in place and only the others are
+// moved, each with a single call. The catch-all rule is never moved or
+// deleted and must be last of the desired list if it is part of it.
+func PlanRulesetRuleOrder(current, desired []*RulesetRule) ([]*RulesetRuleChange, error) {
+	byID := make(map[string]*RulesetRule)
+	var order []string
+	for _, r := range current {
+		byID[r.ID] = r
+		if !r.CatchAll {
+			order = append(order, r.ID)
+		}
+	}
+
+	key := func(i int) string {
+		if desired[i].ID == "" {
+			return fmt.Sprintf("#%d", i)
+		}
+		return desired[i].ID
+	}
+
+	var target []int
+	kept := make(map[string]bool)
+	for i, r := range desired {
+		if r.ID == "" {
+			if r.CatchAll {
+				return nil, fmt.Errorf("rule %d: cannot create a catch-all rule", i)
+			}
+			target = append(target, i)
+			continue
+		}
+		cur, ok := byID[r.ID]
+		if !ok {
+			return nil, fmt.Errorf("rule %d: ruleset has no rule %s", i, r.ID)
+		}
+		if kept[r.ID] {
+			return nil, fmt.Errorf("rule %d: rule %s is listed twice", i, r.ID)
+		}
+		kept[r.ID] = true
+		if cur.CatchAll != r.CatchAll {
+			return nil, fmt.Errorf("rule %d: cannot change whether rule %s is the catch-all rule", i, r.ID)
+		}
+		if r.CatchAll {
+			if i != len(desired)-1 {
+				return nil, fmt.Errorf("rule %d: the catch-all rule %s must be last", i, r.ID)
+			}
+			continue
+		}
+		target = append(target, i)
+	}
+
+	var changes []*RulesetRuleChange
+	for _, r := range current {
+		if !kept[r.ID] && !r.CatchAll {
+			changes = append(changes, &RulesetRuleChange{Type: RulesetRuleChangeDelete, RuleID: r.ID, Index: -1})
+			order = removeRulesetRuleKey(order, r.ID)
+		}
+	}
+
+	stay := rulesetRulesInOrder(order, target, desired)
+	for n, i := range target {
+		r := desired[i]
+		if stay[i] {
+			if rulesetRuleChanged(byID[r.ID], r) {
+				changes = append(changes, &RulesetRuleChange{Type: RulesetRuleChangeUpdate, RuleID: r.ID, Rule: rulesetRuleBody(r, nil), Index: i})
+			}
+			continue
+		}
+
+		order = removeRulesetRuleKey(order, key(i))
+		pos := 0
+		if n > 0 {
+			pos = indexRulesetRuleKey(order, key(target[n-1])) + 1
+		}
+		order = append(order[:pos], append([]string{key(i)}, order[pos:]...)...)
+
+		c := &RulesetRuleChange{Type: RulesetRuleChangeUpdate, RuleID: r.ID, Rule: rulesetRuleBody(r, &pos), Index: i}
+		if r.ID == "" {
+			c.Type = RulesetRuleChangeCreate
+		}
+		changes = append(changes, c)
+	}
+
+	if n := len(desired); n > 0 && desired[n-1].CatchAll && rulesetRuleChanged(byID[desired[n-1].ID], desired[n-1]) {
+		changes = append(changes, &RulesetRuleChange{Type: RulesetRuleChangeUpdate, RuleID: desired[n-1].ID, Rule: rulesetRuleBody(desired[n-1], nil), Index: n - 1})
+	}
+
+	return changes, nil
+}
+
+// rulesetRulesInOrder returns the existing rules of target forming the
+// longest run whose current order matches the desired one.
+func rulesetRulesInOrder(order []string, target []int, desired []*RulesetRule) map[int]bool {
+	var idx, pos []int
+	for _, i := range target {
+		if desired[i].ID != "" {
+			idx = append(idx, i)
+			pos = append(pos, indexRulesetRuleKey(order, desired[i].ID))
+		}
+	}
+
+	stay := make(map[int]bool)
+	for k, ok := range longestIncreasingRun(pos) {
+		if ok {
+			stay[idx[k]] = true
+		}
+	}
+	return stay
+}
+
+func indexRulesetRuleKey(order []string, key string) int {
+	for i, k := range order {
+		if k == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeRulesetRuleKey(order []string, key string) []string {
+	if i := indexRulesetRuleKey(order, key); i >= 0 {
+		return append(order[:i:i], order[i+1:]...)
+	}
+	return order
+}
+
+func rulesetRuleBody(r *RulesetRule, position *int) *RulesetRule {
+	c := *r
+	c.Position = position
+	c.Self = ""
+	return &c
+}
+
+// rulesetRuleChanged reports whether the desired rule differs from the
+// current one in anything else than its position.
+func rulesetRuleChanged(current, desired *RulesetRule) bool {
+	a, _ := json.Marshal(rulesetRuleBody(current, nil))
+	b, _ := json.Marshal(rulesetRuleBody(desired, nil))
+	return string(a) != string(b)
+}
+
+// VerifyRulesetRuleOrder checks that listed rules are in the desired order,
+// with the desired disabled state, and that the catch-all rule is last.
+// Every desired rule must have an ID. A catch-all rule left out of the
+// desired list is expected last.
+func VerifyRulesetRuleOrder(listed, desired []*RulesetRule) error {
+	for i, r := range listed {
+		if r.CatchAll && i != len(listed)-1 {
+			return fmt.Errorf("catch-all rule %s is at position %d of %d", r.ID, i, len(listed))
+		}
+	}
+
+	want := desired
+	if n := len(listed); n > 0 && listed[n-1].CatchAll && (len(desired) == 0 || !desired[len(desired)-1].CatchAll) {
+		want = append(want[:len(want):len(want)], listed[n-1])
+	}
+
+	var got, expected []string
+	for _, r := range listed {
+		got = append(got, r.ID)
+	}
+	for _, r := range want {
+		expected = append(expected, r.ID)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		return fmt.Errorf("rules are in order %v; want %v", got, expected)
+	}
+
+	for i, r := range listed {
+		if r.Disabled != want[i].Disabled {
+			return fmt.Errorf("rule %s has disabled %t; want %t", r.ID, r.Disabled, want[i].Disabled)
+		}
+	}
+	return nil
+}
+
+// PlanRuleOrderContext lists the rules of a ruleset and returns the calls
+// ApplyRuleOrderContext would make to reach the desired ones.
+func (s *RulesetService) PlanRuleOrderContext(ctx context.Context, rulesetID string, desired []*RulesetRule) ([]*RulesetRuleChange, error) {
+	current, err := s.ListAllRulesContext(ctx, rulesetID)
+	if err != nil {
+		return nil, err
+	}
+	return PlanRulesetRuleOrder(current, desired)
+}
+
+// ApplyRuleOrderContext turns the rules of a ruleset into the desired ones
+// with the calls planned by PlanRulesetRuleOrder, then lists them again to
+// verify the result. It returns the listed rules and the changes made,
+// which stop at the first failing call as the API has no transactions.
+func (s *RulesetService) ApplyRuleOrderContext(ctx context.Context, rulesetID string, desired []*RulesetRule) ([]*RulesetRule, []*RulesetRuleChange, error) {
+	changes, err := s.PlanRuleOrderContext(ctx, rulesetID, desired)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Created rules get their IDs from the responses.
+	resolved := append([]*RulesetRule(nil), desired...)
+	for n, c := range changes {
+		switch c.Type {
+		case RulesetRuleChangeCreate:
+			r, _, err := s.CreateRuleContext(ctx, rulesetID, c.Rule)
+			if err != nil {
+				return nil, changes[:n], fmt.Errorf("failed to create rule %d: %v", c.Index, err)
+			}
+			resolved[c.Index] = r
+		case RulesetRuleChangeUpdate:
+			if _, _, err := s.UpdateRuleContext(ctx, rulesetID, c.RuleID, c.Rule); err != nil {
+				return nil, changes[:n], fmt.Errorf("failed to update rule %s: %v", c.RuleID, err)
+			}
+		case RulesetRuleChangeDelete:
+			if _, err := s.DeleteRuleContext(ctx, rulesetID, c.RuleID); err != nil {
+				return nil, changes[:n], fmt.Errorf("failed to delete rule %s: %v", c.RuleID, err)
+			}
+		}
+	}
+
+	listed, err := s.ListAllRulesContext(ctx, rulesetID)
+	if err != nil {
+		return nil, changes, err
+	}
+	if err := VerifyRulesetRuleOrder(listed, resolved); err != nil {
+		return listed, changes, fmt.Errorf("ruleset %s: %v", rulesetID, err)
+	}
+	return listed, changes, nil
+}
+
+// EditRulesContext applies edits to the rules of a ruleset, as
+// ApplyRulesetRuleEdits does, with ApplyRuleOrderContext.
+func (s *RulesetService) EditRulesContext(ctx context.Context, rulesetID string, edits []*RulesetRuleEdit) ([]*RulesetRule, []*RulesetRuleChange, error) {
+	current, err := s.ListAllRulesContext(ctx, rulesetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	desired, err := ApplyRulesetRuleEdits(current, edits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.ApplyRuleOrderContext(ctx, rulesetID, desired)
+}
@@ -0,0 +1,255 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// rulesetRulesServer keeps the rules of ruleset 1 in memory, placing rules
+// at their position the way the API does, and lists them two per page.
+type rulesetRulesServer struct {
+	rules []*RulesetRule
+	calls []string
+	next  int
+}
+
+func setupRulesetRules(t *testing.T, ids ...string) *rulesetRulesServer {
+	srv := &rulesetRulesServer{}
+	for _, id := range ids {
+		srv.rules = append(srv.rules, &RulesetRule{ID: id, CatchAll: id == "catch"})
+	}
+
+	place := func(r *RulesetRule) {
+		pos := len(srv.rules)
+		if n := len(srv.rules); n > 0 && srv.rules[n-1].CatchAll {
+			pos = n - 1
+		}
+		if r.Position != nil && *r.Position < pos {
+			pos = *r.Position
+		}
+		r.Position = nil
+		srv.rules = append(srv.rules[:pos], append([]*RulesetRule{r}, srv.rules[pos:]...)...)
+	}
+	remove := func(id string) *RulesetRule {
+		for i, r := range srv.rules {
+			if r.ID == id {
+				srv.rules = append(srv.rules[:i:i], srv.rules[i+1:]...)
+				return r
+			}
+		}
+		t.Fatalf("no rule %s", id)
+		return nil
+	}
+
+	mux.HandleFunc("/rulesets/1/rules", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			end := offset + 2
+			if end > len(srv.rules) {
+				end = len(srv.rules)
+			}
+			json.NewEncoder(w).Encode(&ListRulesetRulesResponse{Rules: srv.rules[offset:end], Offset: offset, Limit: 2, More: end < len(srv.rules)})
+		case "POST":
+			v := new(RulesetRulePayload)
+			json.NewDecoder(r.Body).Decode(v)
+			srv.next++
+			v.Rule.ID = fmt.Sprintf("N%d", srv.next)
+			srv.calls = append(srv.calls, fmt.Sprintf("POST %s@%d", v.Rule.ID, *v.Rule.Position))
+			place(v.Rule)
+			json.NewEncoder(w).Encode(v)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/rulesets/1/rules/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/rulesets/1/rules/")
+		switch r.Method {
+		case "PUT":
+			v := new(RulesetRulePayload)
+			json.NewDecoder(r.Body).Decode(v)
+			call := "PUT " + id
+			if v.Rule.Position != nil {
+				call += fmt.Sprintf("@%d", *v.Rule.Position)
+				old := remove(id)
+				old.Disabled = v.Rule.Disabled
+				old.Position = v.Rule.Position
+				place(old)
+			} else {
+				for _, rule := range srv.rules {
+					if rule.ID == id {
+						rule.Disabled = v.Rule.Disabled
+					}
+				}
+			}
+			srv.calls = append(srv.calls, call)
+			json.NewEncoder(w).Encode(v)
+		case "DELETE":
+			srv.calls = append(srv.calls, "DELETE "+id)
+			remove(id)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	return srv
+}
+
+func (srv *rulesetRulesServer) ids() []string {
+	var ids []string
+	for _, r := range srv.rules {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func TestRulesetListAllRules(t *testing.T) {
+	setup()
+	defer teardown()
+	setupRulesetRules(t, "A", "B", "C", "catch")
+
+	rules, err := client.Rulesets.ListAllRulesContext(context.Background(), "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 4 || rules[3].ID != "catch" {
+		t.Errorf("returned %+v", rules)
+	}
+}
+
+func TestPlanRulesetRuleOrder(t *testing.T) {
+	rules := func(ids ...string) []*RulesetRule {
+		var rules []*RulesetRule
+		for _, id := range ids {
+			rules = append(rules, &RulesetRule{ID: id, CatchAll: id == "catch"})
+		}
+		return rules
+	}
+
+	cases := []struct {
+		current []string
+		desired []string
+		want    []string
+	}{
+		{[]string{"A", "B", "C", "D"}, []string{"A", "B", "C", "D"}, nil},
+		{[]string{"A", "B", "C", "D"}, []string{"B", "C", "D", "A"}, []string{"update A at 3"}},
+		{[]string{"A", "B", "C", "D"}, []string{"D", "A", "B", "C"}, []string{"update D at 0"}},
+		{[]string{"D", "A", "C", "B", "catch"}, []string{"A", "B", "C", "D"}, []string{"update C at 3", "update D at 3"}},
+		{[]string{"A", "B", "C", "catch"}, []string{"C", "", "A", "catch"}, []string{"delete B", "create at 2", "update A at 2"}},
+	}
+	for _, c := range cases {
+		desired := rules(c.desired...)
+		changes, err := PlanRulesetRuleOrder(rules(c.current...), desired)
+		if err != nil {
+			t.Errorf("%v -> %v: %v", c.current, c.desired, err)
+			continue
+		}
+		var got []string
+		for _, ch := range changes {
+			got = append(got, ch.String())
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%v -> %v: changes %v; want %v", c.current, c.desired, got, c.want)
+		}
+	}
+
+	invalid := [][]string{
+		{"catch", "A"},
+		{"A", "A"},
+		{"Z"},
+	}
+	for _, desired := range invalid {
+		if _, err := PlanRulesetRuleOrder(rules("A", "catch"), rules(desired...)); err == nil {
+			t.Errorf("expected an error for %v", desired)
+		}
+	}
+}
+
+func TestApplyRulesetRuleEdits(t *testing.T) {
+	current := []*RulesetRule{{ID: "A"}, {ID: "B"}, {ID: "C"}, {ID: "catch", CatchAll: true}}
+	inserted := &RulesetRule{}
+
+	result, err := ApplyRulesetRuleEdits(current, []*RulesetRuleEdit{
+		{Type: RulesetRuleEditMove, RuleID: "C", Position: 0},
+		{Type: RulesetRuleEditDelete, RuleID: "A"},
+		{Type: RulesetRuleEditInsert, Rule: inserted, Position: 2},
+		{Type: RulesetRuleEditDisable, RuleID: "B"},
+		{Type: RulesetRuleEditDisable, RuleID: "catch"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*RulesetRule{{ID: "C"}, {ID: "B", Disabled: true}, inserted, {ID: "catch", CatchAll: true, Disabled: true}}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("returned %+v; want %+v", result, want)
+	}
+	if current[1].Disabled || current[0].ID != "A" {
+		t.Error("the given rules were changed")
+	}
+
+	invalid := []*RulesetRuleEdit{
+		{Type: RulesetRuleEditMove, RuleID: "catch"},
+		{Type: RulesetRuleEditDelete, RuleID: "catch"},
+		{Type: RulesetRuleEditInsert, Rule: &RulesetRule{CatchAll: true}},
+		{Type: RulesetRuleEditMove, RuleID: "A", Position: 3},
+		{Type: RulesetRuleEditEnable, RuleID: "Z"},
+	}
+	for _, e := range invalid {
+		if _, err := ApplyRulesetRuleEdits(current, []*RulesetRuleEdit{e}); err == nil {
+			t.Errorf("expected an error for %+v", e)
+		}
+	}
+}
+
+func TestRulesetEditRules(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupRulesetRules(t, "A", "B", "C", "D", "catch")
+
+	listed, changes, err := client.Rulesets.EditRulesContext(context.Background(), "1", []*RulesetRuleEdit{
+		{Type: RulesetRuleEditMove, RuleID: "A", Position: 3},
+		{Type: RulesetRuleEditInsert, Rule: &RulesetRule{}, Position: 0},
+		{Type: RulesetRuleEditDelete, RuleID: "C"},
+		{Type: RulesetRuleEditDisable, RuleID: "catch"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantCalls := []string{"DELETE C", "POST N1@0", "PUT A@3", "PUT catch"}
+	if !reflect.DeepEqual(srv.calls, wantCalls) {
+		t.Errorf("calls %v; want %v", srv.calls, wantCalls)
+	}
+	if len(changes) != len(wantCalls) {
+		t.Errorf("returned %d changes", len(changes))
+	}
+	if want := []string{"N1", "B", "D", "A", "catch"}; !reflect.DeepEqual(srv.ids(), want) || len(listed) != len(want) {
+		t.Errorf("rules %v; want %v", srv.ids(), want)
+	}
+	if !srv.rules[4].Disabled {
+		t.Error("catch-all rule was not disabled")
+	}
+}
+
+func TestRulesetApplyRuleOrderVerify(t *testing.T) {
+	setup()
+	defer teardown()
+	srv := setupRulesetRules(t, "A", "B", "catch")
+
+	// Acknowledge the move of A without making it to fail verification.
+	mux.HandleFunc("/rulesets/1/rules/A", func(w http.ResponseWriter, r *http.Request) {
+		srv.calls = append(srv.calls, "PUT A")
+		json.NewEncoder(w).Encode(&RulesetRulePayload{Rule: &RulesetRule{ID: "A"}})
+	})
+
+	_, _, err := client.Rulesets.ApplyRuleOrderContext(context.Background(), "1", []*RulesetRule{{ID: "B"}, {ID: "A"}})
+	if err == nil || !strings.Contains(err.Error(), "rules are in order [A B catch]; want [B A catch]") {
+		t.Errorf("expected a verification error, got %v", err)
+	}
+}
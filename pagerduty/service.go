@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -219,10 +220,26 @@ type GetServiceOptions struct {
 
 // ListServiceEventRuleOptions represents options when retrieving a list of event rules for a service
 type ListServiceEventRuleOptions struct {
-	Limit  int  `json:"limit,omitempty"`
-	More   bool `json:"more,omitempty"`
-	Offset int  `json:"offset,omitempty"`
-	Total  int  `json:"total,omitempty"`
+	Limit  int  `json:"limit,omitempty" url:"limit,omitempty"`
+	More   bool `json:"more,omitempty" url:"-"`
+	Offset int  `json:"offset,omitempty" url:"offset,omitempty"`
+	Total  int  `json:"total,omitempty" url:"-"`
+}
+
+type listServiceEventRuleOptionsGen struct {
+	options *ListServiceEventRuleOptions
+}
+
+func (o *listServiceEventRuleOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listServiceEventRuleOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listServiceEventRuleOptionsGen) buildStruct() interface{} {
+	return o.options
 }
 
 // ListServiceEventRuleResponse represents a list of event rules for a service
@@ -339,10 +356,15 @@ func (s *ServicesService) DeleteIntegration(serviceID, integrationID string) (*R
 
 // ListEventRules lists existing service event rules.
 func (s *ServicesService) ListEventRules(serviceID string, o *ListServiceEventRuleOptions) (*ListServiceEventRuleResponse, *Response, error) {
+	return s.ListEventRulesContext(context.Background(), serviceID, o)
+}
+
+// ListEventRulesContext lists existing service event rules.
+func (s *ServicesService) ListEventRulesContext(ctx context.Context, serviceID string, o *ListServiceEventRuleOptions) (*ListServiceEventRuleResponse, *Response, error) {
 	u := fmt.Sprintf("/services/%s/rules", serviceID)
 	v := new(ListServiceEventRuleResponse)
 
-	resp, err := s.client.newRequestDo("GET", u, o, nil, &v)
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, &v)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -350,6 +372,42 @@ func (s *ServicesService) ListEventRules(serviceID string, o *ListServiceEventRu
 	return v, resp, nil
 }
 
+// ListAllEventRulesContext lists every event rule of a service, following
+// pages from the offset of o if given.
+func (s *ServicesService) ListAllEventRulesContext(ctx context.Context, serviceID string, o *ListServiceEventRuleOptions) ([]*ServiceEventRule, error) {
+	u := fmt.Sprintf("/services/%s/rules", serviceID)
+	rules := make([]*ServiceEventRule, 0)
+
+	opts := ListServiceEventRuleOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListServiceEventRuleResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		rules = append(rules, result.EventRules...)
+
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listServiceEventRuleOptionsGen{
+		options: &opts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
 // CreateEventRule creates a new service event rule.
 func (s *ServicesService) CreateEventRule(serviceID string, eventRule *ServiceEventRule) (*ServiceEventRule, *Response, error) {
 	u := fmt.Sprintf("/services/%s/rules", serviceID)
@@ -0,0 +1,224 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ServiceCustomFieldService handles the communication with custom field on services related methods of the PagerDuty API.
+type ServiceCustomFieldService service
+
+// ServiceCustomField represents a service custom field.
+type ServiceCustomField struct {
+	ID           string                       `json:"id,omitempty"`
+	Name         string                       `json:"name,omitempty"`
+	DisplayName  string                       `json:"display_name,omitempty"`
+	Type         string                       `json:"type,omitempty"`
+	Summary      string                       `json:"summary,omitempty"`
+	Self         string                       `json:"self,omitempty"`
+	DataType     IncidentCustomFieldDataType  `json:"data_type,omitempty"`
+	FieldType    IncidentCustomFieldFieldType `json:"field_type,omitempty"`
+	Description  *string                      `json:"description,omitempty"`
+	DefaultValue interface{}                  `json:"default_value,omitempty"`
+	FieldOptions []*IncidentCustomFieldOption `json:"field_options,omitempty"`
+	Enabled      *bool                        `json:"enabled,omitempty"`
+}
+
+type rawServiceCustomField ServiceCustomField
+
+func (d *ServiceCustomField) UnmarshalJSON(data []byte) error {
+	var p rawServiceCustomField
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return err
+	}
+	*d = ServiceCustomField(p)
+	if p.DefaultValue != nil && p.DataType == IncidentCustomFieldDataTypeInt {
+		d.DefaultValue, err = convertIncidentCustomFieldInt(p.FieldType, p.DefaultValue)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServiceCustomFieldValue represents the value of a custom field on a service.
+type ServiceCustomFieldValue struct {
+	ID          string                       `json:"id,omitempty"`
+	Name        string                       `json:"name,omitempty"`
+	DisplayName string                       `json:"display_name,omitempty"`
+	Type        string                       `json:"type,omitempty"`
+	Description *string                      `json:"description,omitempty"`
+	DataType    IncidentCustomFieldDataType  `json:"data_type,omitempty"`
+	FieldType   IncidentCustomFieldFieldType `json:"field_type,omitempty"`
+	Value       interface{}                  `json:"value"`
+}
+
+type rawServiceCustomFieldValue ServiceCustomFieldValue
+
+func (d *ServiceCustomFieldValue) UnmarshalJSON(data []byte) error {
+	var p rawServiceCustomFieldValue
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return err
+	}
+	*d = ServiceCustomFieldValue(p)
+	if p.Value != nil && p.DataType == IncidentCustomFieldDataTypeInt {
+		d.Value, err = convertIncidentCustomFieldInt(p.FieldType, p.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServiceCustomFieldPayload represents payload with a service custom field object
+type ServiceCustomFieldPayload struct {
+	Field *ServiceCustomField `json:"field,omitempty"`
+}
+
+// ListServiceCustomFieldsResponse represents a list response of service custom fields
+type ListServiceCustomFieldsResponse struct {
+	Fields []*ServiceCustomField `json:"fields,omitempty"`
+}
+
+// ListServiceCustomFieldsOptions represents options when retrieving a list of service custom fields.
+type ListServiceCustomFieldsOptions struct {
+	Includes []string `url:"include,brackets,omitempty"`
+}
+
+// ServiceCustomFieldValuesPayload represents payload with the custom field values of a service
+type ServiceCustomFieldValuesPayload struct {
+	CustomFields []*ServiceCustomFieldValue `json:"custom_fields"`
+}
+
+// ListContext lists existing service custom fields.
+func (s *ServiceCustomFieldService) ListContext(ctx context.Context, o *ListServiceCustomFieldsOptions) (*ListServiceCustomFieldsResponse, *Response, error) {
+	u := "/services/custom_fields"
+	v := new(ListServiceCustomFieldsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetContext gets a service custom field.
+func (s *ServiceCustomFieldService) GetContext(ctx context.Context, id string, o *ListServiceCustomFieldsOptions) (*ServiceCustomField, *Response, error) {
+	u := fmt.Sprintf("/services/custom_fields/%s", id)
+	v := new(ServiceCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// CreateContext creates a new service custom field.
+func (s *ServiceCustomFieldService) CreateContext(ctx context.Context, field *ServiceCustomField) (*ServiceCustomField, *Response, error) {
+	u := "/services/custom_fields"
+	v := new(ServiceCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &ServiceCustomFieldPayload{Field: field}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// UpdateContext updates an existing service custom field.
+func (s *ServiceCustomFieldService) UpdateContext(ctx context.Context, id string, field *ServiceCustomField) (*ServiceCustomField, *Response, error) {
+	u := fmt.Sprintf("/services/custom_fields/%s", id)
+	v := new(ServiceCustomFieldPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &ServiceCustomFieldPayload{Field: field}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Field, resp, nil
+}
+
+// DeleteContext removes an existing service custom field.
+func (s *ServiceCustomFieldService) DeleteContext(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("/services/custom_fields/%s", id)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// ListFieldOptionsContext lists the field options of a service custom field.
+func (s *ServiceCustomFieldService) ListFieldOptionsContext(ctx context.Context, fieldID string) (*ListIncidentCustomFieldOptionsResponse, *Response, error) {
+	u := fmt.Sprintf("/services/custom_fields/%s/field_options", fieldID)
+	v := new(ListIncidentCustomFieldOptionsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// CreateFieldOptionContext creates a new field option on a service custom field.
+func (s *ServiceCustomFieldService) CreateFieldOptionContext(ctx context.Context, fieldID string, fieldOption *IncidentCustomFieldOption) (*IncidentCustomFieldOption, *Response, error) {
+	u := fmt.Sprintf("/services/custom_fields/%s/field_options", fieldID)
+	v := new(IncidentCustomFieldOptionPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &IncidentCustomFieldOptionPayload{FieldOption: fieldOption}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.FieldOption, resp, nil
+}
+
+// UpdateFieldOptionContext updates an existing field option of a service custom field.
+func (s *ServiceCustomFieldService) UpdateFieldOptionContext(ctx context.Context, fieldID, fieldOptionID string, fieldOption *IncidentCustomFieldOption) (*IncidentCustomFieldOption, *Response, error) {
+	u := fmt.Sprintf("/services/custom_fields/%s/field_options/%s", fieldID, fieldOptionID)
+	v := new(IncidentCustomFieldOptionPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &IncidentCustomFieldOptionPayload{FieldOption: fieldOption}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.FieldOption, resp, nil
+}
+
+// DeleteFieldOptionContext removes an existing field option from a service custom field.
+func (s *ServiceCustomFieldService) DeleteFieldOptionContext(ctx context.Context, fieldID, fieldOptionID string) (*Response, error) {
+	u := fmt.Sprintf("/services/custom_fields/%s/field_options/%s", fieldID, fieldOptionID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// ListValuesContext lists the custom field values of a service.
+func (s *ServiceCustomFieldService) ListValuesContext(ctx context.Context, serviceID string) ([]*ServiceCustomFieldValue, *Response, error) {
+	u := fmt.Sprintf("/services/%s/custom_fields/values", serviceID)
+	v := new(ServiceCustomFieldValuesPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CustomFields, resp, nil
+}
+
+// UpdateValuesContext sets custom field values on a service. Each value identifies its field by ID or Name; fields not
+// included are left unchanged.
+func (s *ServiceCustomFieldService) UpdateValuesContext(ctx context.Context, serviceID string, values []*ServiceCustomFieldValue) ([]*ServiceCustomFieldValue, *Response, error) {
+	u := fmt.Sprintf("/services/%s/custom_fields/values", serviceID)
+	v := new(ServiceCustomFieldValuesPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &ServiceCustomFieldValuesPayload{CustomFields: values}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.CustomFields, resp, nil
+}
@@ -0,0 +1,121 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestServiceCustomFieldList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/services/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"fields": [{"id": "F1", "name": "tier", "data_type": "integer", "field_type": "multi_value", "default_value": [1, 2], "field_options": [{"id": "O1", "data": {"data_type": "integer", "value": 1}}]}]}`))
+	})
+
+	resp, _, err := client.ServiceCustomFields.ListContext(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListServiceCustomFieldsResponse{
+		Fields: []*ServiceCustomField{
+			{
+				ID:           "F1",
+				Name:         "tier",
+				DataType:     IncidentCustomFieldDataTypeInt,
+				FieldType:    IncidentCustomFieldFieldTypeMultiValue,
+				DefaultValue: []interface{}{int64(1), int64(2)},
+				FieldOptions: []*IncidentCustomFieldOption{
+					{ID: "O1", Data: &IncidentCustomFieldOptionData{DataType: IncidentCustomFieldDataTypeInt, Value: float64(1)}},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestServiceCustomFieldCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	enabled := true
+
+	mux.HandleFunc("/services/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"field":{"name":"owner","display_name":"Owner","data_type":"string","field_type":"single_value","enabled":true}}`)
+		w.Write([]byte(`{"field": {"id": "F2", "name": "owner", "display_name": "Owner", "data_type": "string", "field_type": "single_value", "enabled": true}}`))
+	})
+
+	resp, _, err := client.ServiceCustomFields.CreateContext(context.Background(), &ServiceCustomField{
+		Name:        "owner",
+		DisplayName: "Owner",
+		DataType:    IncidentCustomFieldDataTypeString,
+		FieldType:   IncidentCustomFieldFieldTypeSingleValue,
+		Enabled:     &enabled,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ServiceCustomField{
+		ID:          "F2",
+		Name:        "owner",
+		DisplayName: "Owner",
+		DataType:    IncidentCustomFieldDataTypeString,
+		FieldType:   IncidentCustomFieldFieldTypeSingleValue,
+		Enabled:     &enabled,
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", resp, want)
+	}
+}
+
+func TestServiceCustomFieldValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/services/PSVC1/custom_fields/values", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"custom_fields": [{"id": "F1", "name": "tier", "data_type": "integer", "field_type": "single_value", "value": 2}, {"id": "F2", "name": "owner", "data_type": "string", "field_type": "single_value", "value": null}]}`))
+		case "PUT":
+			testBody(t, r, `{"custom_fields":[{"name":"owner","value":"sre"}]}`)
+			w.Write([]byte(`{"custom_fields": [{"id": "F2", "name": "owner", "data_type": "string", "field_type": "single_value", "value": "sre"}]}`))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	values, _, err := client.ServiceCustomFields.ListValuesContext(context.Background(), "PSVC1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ServiceCustomFieldValue{
+		{ID: "F1", Name: "tier", DataType: IncidentCustomFieldDataTypeInt, FieldType: IncidentCustomFieldFieldTypeSingleValue, Value: int64(2)},
+		{ID: "F2", Name: "owner", DataType: IncidentCustomFieldDataTypeString, FieldType: IncidentCustomFieldFieldTypeSingleValue},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", values, want)
+	}
+
+	values, _, err = client.ServiceCustomFields.UpdateValuesContext(context.Background(), "PSVC1", []*ServiceCustomFieldValue{
+		{Name: "owner", Value: "sre"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []*ServiceCustomFieldValue{
+		{ID: "F2", Name: "owner", DataType: IncidentCustomFieldDataTypeString, FieldType: IncidentCustomFieldFieldTypeSingleValue, Value: "sre"},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("returned \n\n%#v want \n\n%#v", values, want)
+	}
+}
@@ -0,0 +1,161 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// StandardService handles the communication with service standard
+// related methods of the PagerDuty API.
+type StandardService service
+
+// StandardResourceType is the type of resource a standard applies to.
+type StandardResourceType string
+
+const (
+	StandardResourceTypeTechnicalServices StandardResourceType = "technical_services"
+	StandardResourceTypeTeams             StandardResourceType = "teams"
+)
+
+// Standard represents a service standard.
+type Standard struct {
+	ID           string                         `json:"id,omitempty"`
+	Type         string                         `json:"type,omitempty"`
+	Name         string                         `json:"name,omitempty"`
+	Description  string                         `json:"description,omitempty"`
+	Active       *bool                          `json:"active,omitempty"`
+	ResourceType string                         `json:"resource_type,omitempty"`
+	Exclusions   *[]*StandardInclusionExclusion `json:"exclusions,omitempty"`
+	Inclusions   *[]*StandardInclusionExclusion `json:"inclusions,omitempty"`
+}
+
+// StandardInclusionExclusion represents a resource explicitly included in or
+// excluded from a standard.
+type StandardInclusionExclusion struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// ListStandardsOptions represents options when listing standards.
+type ListStandardsOptions struct {
+	Active       *bool                `url:"active,omitempty"`
+	ResourceType StandardResourceType `url:"resource_type,omitempty"`
+}
+
+// ListStandardsResponse represents a list response of standards.
+type ListStandardsResponse struct {
+	Standards []*Standard `json:"standards,omitempty"`
+}
+
+// StandardResourceScores represents how a resource scores against the
+// standards that apply to it.
+type StandardResourceScores struct {
+	ResourceID   string                 `json:"resource_id,omitempty"`
+	ResourceType string                 `json:"resource_type,omitempty"`
+	Score        *StandardScore         `json:"score,omitempty"`
+	Standards    []*StandardScoreResult `json:"standards,omitempty"`
+}
+
+// StandardScore represents the number of standards a resource passes.
+type StandardScore struct {
+	Passing int `json:"passing"`
+	Total   int `json:"total"`
+}
+
+// StandardScoreResult represents whether a resource passes a single standard.
+type StandardScoreResult struct {
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Active      bool   `json:"active,omitempty"`
+	Pass        bool   `json:"pass"`
+}
+
+// ListStandardResourceScoresOptions represents options when listing the
+// scores of many resources.
+type ListStandardResourceScoresOptions struct {
+	IDs []string `url:"ids,omitempty,comma"`
+}
+
+// ListStandardResourceScoresResponse represents a list response of resource
+// scores.
+type ListStandardResourceScoresResponse struct {
+	Resources []*StandardResourceScores `json:"resources,omitempty"`
+}
+
+// List lists existing standards.
+func (s *StandardService) List(o *ListStandardsOptions) (*ListStandardsResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing standards.
+func (s *StandardService) ListContext(ctx context.Context, o *ListStandardsOptions) (*ListStandardsResponse, *Response, error) {
+	u := "/standards"
+	v := new(ListStandardsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Update updates the activation, description and exclusions of a standard.
+func (s *StandardService) Update(id string, standard *Standard) (*Standard, *Response, error) {
+	return s.UpdateContext(context.Background(), id, standard)
+}
+
+// UpdateContext updates the activation, description and exclusions of a standard.
+// Nil exclusions and inclusions are left unchanged; pointers to empty lists
+// clear them.
+func (s *StandardService) UpdateContext(ctx context.Context, id string, standard *Standard) (*Standard, *Response, error) {
+	u := fmt.Sprintf("/standards/%s", id)
+	v := new(Standard)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, standard, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetResourceScores gets the standard scores of a single resource.
+func (s *StandardService) GetResourceScores(resourceType StandardResourceType, id string) (*StandardResourceScores, *Response, error) {
+	return s.GetResourceScoresContext(context.Background(), resourceType, id)
+}
+
+// GetResourceScoresContext gets the standard scores of a single resource.
+func (s *StandardService) GetResourceScoresContext(ctx context.Context, resourceType StandardResourceType, id string) (*StandardResourceScores, *Response, error) {
+	u := fmt.Sprintf("/standards/scores/%s/%s", resourceType, id)
+	v := new(StandardResourceScores)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// ListResourceScores lists the standard scores of many resources of the
+// same type.
+func (s *StandardService) ListResourceScores(resourceType StandardResourceType, o *ListStandardResourceScoresOptions) (*ListStandardResourceScoresResponse, *Response, error) {
+	return s.ListResourceScoresContext(context.Background(), resourceType, o)
+}
+
+// ListResourceScoresContext lists the standard scores of many resources of
+// the same type.
+func (s *StandardService) ListResourceScoresContext(ctx context.Context, resourceType StandardResourceType, o *ListStandardResourceScoresOptions) (*ListStandardResourceScoresResponse, *Response, error) {
+	u := fmt.Sprintf("/standards/scores/%s", resourceType)
+	v := new(ListStandardResourceScoresResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,177 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStandardsList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/standards", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "active", "true")
+		testQueryValue(t, r, "resource_type", "technical_services")
+		w.Write([]byte(`{"standards": [{"id": "PSTD1", "name": "Has runbook", "active": true, "resource_type": "technical_service", "exclusions": [{"id": "PSVC1", "type": "technical_service_reference"}]}]}`))
+	})
+
+	active := true
+	resp, _, err := client.Standards.List(&ListStandardsOptions{Active: &active, ResourceType: StandardResourceTypeTechnicalServices})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListStandardsResponse{
+		Standards: []*Standard{
+			{
+				ID:           "PSTD1",
+				Name:         "Has runbook",
+				Active:       &active,
+				ResourceType: "technical_service",
+				Exclusions:   &[]*StandardInclusionExclusion{{ID: "PSVC1", Type: "technical_service_reference"}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestStandardsUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	active := false
+	input := &Standard{
+		Active:     &active,
+		Exclusions: &[]*StandardInclusionExclusion{{ID: "PSVC2", Type: "technical_service_reference"}},
+	}
+
+	mux.HandleFunc("/standards/PSTD1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		v := new(Standard)
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		w.Write([]byte(`{"id": "PSTD1", "active": false, "exclusions": [{"id": "PSVC2", "type": "technical_service_reference"}]}`))
+	})
+
+	resp, _, err := client.Standards.Update("PSTD1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Standard{
+		ID:         "PSTD1",
+		Active:     &active,
+		Exclusions: &[]*StandardInclusionExclusion{{ID: "PSVC2", Type: "technical_service_reference"}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestStandardsUpdateClearsExclusions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/standards/PSTD1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"exclusions":[]`) || !strings.Contains(string(body), `"inclusions":[]`) {
+			t.Errorf("Request body = %s, want empty exclusions and inclusions", body)
+		}
+		w.Write([]byte(`{"id": "PSTD1", "exclusions": [], "inclusions": []}`))
+	})
+
+	input := &Standard{
+		Exclusions: &[]*StandardInclusionExclusion{},
+		Inclusions: &[]*StandardInclusionExclusion{},
+	}
+	if _, _, err := client.Standards.Update("PSTD1", input); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStandardsUpdateActiveOnly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/standards/PSTD1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if strings.Contains(string(body), "exclusions") || strings.Contains(string(body), "inclusions") {
+			t.Errorf("Request body = %s, want no exclusions or inclusions", body)
+		}
+		w.Write([]byte(`{"id": "PSTD1", "active": true}`))
+	})
+
+	active := true
+	if _, _, err := client.Standards.Update("PSTD1", &Standard{Active: &active}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStandardsGetResourceScores(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/standards/scores/technical_services/PSVC1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"resource_id": "PSVC1", "resource_type": "technical_service", "score": {"passing": 1, "total": 2}, "standards": [{"id": "PSTD1", "active": true, "pass": true}, {"id": "PSTD2", "active": true, "pass": false}]}`))
+	})
+
+	resp, _, err := client.Standards.GetResourceScores(StandardResourceTypeTechnicalServices, "PSVC1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &StandardResourceScores{
+		ResourceID:   "PSVC1",
+		ResourceType: "technical_service",
+		Score:        &StandardScore{Passing: 1, Total: 2},
+		Standards: []*StandardScoreResult{
+			{ID: "PSTD1", Active: true, Pass: true},
+			{ID: "PSTD2", Active: true, Pass: false},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestStandardsListResourceScores(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/standards/scores/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "ids", "PTEAM1,PTEAM2")
+		w.Write([]byte(`{"resources": [{"resource_id": "PTEAM1", "score": {"passing": 2, "total": 2}}, {"resource_id": "PTEAM2", "score": {"passing": 0, "total": 2}}]}`))
+	})
+
+	resp, _, err := client.Standards.ListResourceScores(StandardResourceTypeTeams, &ListStandardResourceScoresOptions{IDs: []string{"PTEAM1", "PTEAM2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListStandardResourceScoresResponse{
+		Resources: []*StandardResourceScores{
+			{ResourceID: "PTEAM1", Score: &StandardScore{Passing: 2, Total: 2}},
+			{ResourceID: "PTEAM2", Score: &StandardScore{Passing: 0, Total: 2}},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
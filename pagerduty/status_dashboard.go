@@ -0,0 +1,125 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusDashboardService handles the communication with status dashboard
+// related methods of the PagerDuty API.
+type StatusDashboardService service
+
+// StatusDashboard represents a status dashboard.
+type StatusDashboard struct {
+	ID      string `json:"id,omitempty"`
+	URLSlug string `json:"url_slug,omitempty"`
+	Name    string `json:"name,omitempty"`
+}
+
+// StatusDashboardPayload represents payload with a status dashboard object.
+type StatusDashboardPayload struct {
+	StatusDashboard *StatusDashboard `json:"status_dashboard,omitempty"`
+}
+
+// ListStatusDashboardsResponse represents a list response of status dashboards.
+type ListStatusDashboardsResponse struct {
+	StatusDashboards []*StatusDashboard `json:"status_dashboards,omitempty"`
+}
+
+// ListStatusDashboardServiceImpactsResponse represents a list response of
+// service impacts of a status dashboard.
+type ListStatusDashboardServiceImpactsResponse struct {
+	Services []*BusinessServiceImpact `json:"services,omitempty"`
+	Limit    int                      `json:"limit,omitempty"`
+	More     bool                     `json:"more,omitempty"`
+}
+
+type listServiceImpactsOptions struct {
+	AdditionalFields []string `url:"additional_fields,omitempty,brackets"`
+}
+
+// List lists existing status dashboards.
+func (s *StatusDashboardService) List() (*ListStatusDashboardsResponse, *Response, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext lists existing status dashboards.
+func (s *StatusDashboardService) ListContext(ctx context.Context) (*ListStatusDashboardsResponse, *Response, error) {
+	u := "/status_dashboards"
+	v := new(ListStatusDashboardsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Get gets a status dashboard by its ID.
+func (s *StatusDashboardService) Get(id string) (*StatusDashboard, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext gets a status dashboard by its ID.
+func (s *StatusDashboardService) GetContext(ctx context.Context, id string) (*StatusDashboard, *Response, error) {
+	return s.get(ctx, fmt.Sprintf("/status_dashboards/%s", id))
+}
+
+// GetByURLSlug gets a status dashboard by its URL slug.
+func (s *StatusDashboardService) GetByURLSlug(slug string) (*StatusDashboard, *Response, error) {
+	return s.GetByURLSlugContext(context.Background(), slug)
+}
+
+// GetByURLSlugContext gets a status dashboard by its URL slug.
+func (s *StatusDashboardService) GetByURLSlugContext(ctx context.Context, slug string) (*StatusDashboard, *Response, error) {
+	return s.get(ctx, fmt.Sprintf("/status_dashboards/url_slugs/%s", slug))
+}
+
+func (s *StatusDashboardService) get(ctx context.Context, u string) (*StatusDashboard, *Response, error) {
+	v := new(StatusDashboardPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.StatusDashboard, resp, nil
+}
+
+// ListServiceImpacts lists the impact of incidents on the business services
+// of a status dashboard.
+func (s *StatusDashboardService) ListServiceImpacts(id string) (*ListStatusDashboardServiceImpactsResponse, *Response, error) {
+	return s.ListServiceImpactsContext(context.Background(), id)
+}
+
+// ListServiceImpactsContext lists the impact of incidents on the business
+// services of a status dashboard.
+func (s *StatusDashboardService) ListServiceImpactsContext(ctx context.Context, id string) (*ListStatusDashboardServiceImpactsResponse, *Response, error) {
+	return s.listServiceImpacts(ctx, fmt.Sprintf("/status_dashboards/%s/service_impacts", id))
+}
+
+// ListServiceImpactsByURLSlug lists the impact of incidents on the business
+// services of a status dashboard identified by its URL slug.
+func (s *StatusDashboardService) ListServiceImpactsByURLSlug(slug string) (*ListStatusDashboardServiceImpactsResponse, *Response, error) {
+	return s.ListServiceImpactsByURLSlugContext(context.Background(), slug)
+}
+
+// ListServiceImpactsByURLSlugContext lists the impact of incidents on the
+// business services of a status dashboard identified by its URL slug.
+func (s *StatusDashboardService) ListServiceImpactsByURLSlugContext(ctx context.Context, slug string) (*ListStatusDashboardServiceImpactsResponse, *Response, error) {
+	return s.listServiceImpacts(ctx, fmt.Sprintf("/status_dashboards/url_slugs/%s/service_impacts", slug))
+}
+
+func (s *StatusDashboardService) listServiceImpacts(ctx context.Context, u string) (*ListStatusDashboardServiceImpactsResponse, *Response, error) {
+	v := new(ListStatusDashboardServiceImpactsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, &listServiceImpactsOptions{
+		AdditionalFields: []string{"services.highest_impacting_priority"},
+	}, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,109 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestStatusDashboardsList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/status_dashboards", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"status_dashboards": [{"id": "PDASH1", "url_slug": "main", "name": "Main"}]}`))
+	})
+
+	resp, _, err := client.StatusDashboards.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListStatusDashboardsResponse{
+		StatusDashboards: []*StatusDashboard{{ID: "PDASH1", URLSlug: "main", Name: "Main"}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestStatusDashboardsGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := []byte(`{"status_dashboard": {"id": "PDASH1", "url_slug": "main", "name": "Main"}}`)
+	mux.HandleFunc("/status_dashboards/PDASH1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(body)
+	})
+	mux.HandleFunc("/status_dashboards/url_slugs/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(body)
+	})
+
+	want := &StatusDashboard{ID: "PDASH1", URLSlug: "main", Name: "Main"}
+
+	resp, _, err := client.StatusDashboards.Get("PDASH1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	resp, _, err = client.StatusDashboards.GetByURLSlug("main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestStatusDashboardsListServiceImpacts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := []byte(`{"services": [{"id": "PBS1", "name": "Checkout", "type": "business_service", "status": "impacted", "additional_fields": {"highest_impacting_priority": {"id": "PPRIO1", "order": 2}}}]}`)
+	mux.HandleFunc("/status_dashboards/PDASH1/service_impacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "additional_fields[]", "services.highest_impacting_priority")
+		w.Write(body)
+	})
+	mux.HandleFunc("/status_dashboards/url_slugs/main/service_impacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(body)
+	})
+
+	want := &ListStatusDashboardServiceImpactsResponse{
+		Services: []*BusinessServiceImpact{
+			{
+				ID:     "PBS1",
+				Name:   "Checkout",
+				Type:   "business_service",
+				Status: BusinessServiceImpactStatusImpacted,
+				AdditionalFields: &BusinessServiceImpactAdditionalFields{
+					HighestImpactingPriority: &PriorityReference{ID: "PPRIO1", Order: 2},
+				},
+			},
+		},
+	}
+
+	resp, _, err := client.StatusDashboards.ListServiceImpacts("PDASH1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+
+	resp, _, err = client.StatusDashboards.ListServiceImpactsByURLSlug("main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
@@ -0,0 +1,433 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusPageService handles the communication with status page
+// related methods of the PagerDuty API.
+type StatusPageService service
+
+// StatusPage represents a status page.
+type StatusPage struct {
+	ID             string `json:"id,omitempty"`
+	Type           string `json:"type,omitempty"`
+	Name           string `json:"name,omitempty"`
+	StatusPageType string `json:"status_page_type,omitempty"`
+	URL            string `json:"url,omitempty"`
+	PublishedAt    string `json:"published_at,omitempty"`
+}
+
+// StatusPagePost represents an incident or maintenance post of a status page.
+type StatusPagePost struct {
+	ID         string                  `json:"id,omitempty"`
+	Type       string                  `json:"type,omitempty"`
+	Self       string                  `json:"self,omitempty"`
+	PostType   string                  `json:"post_type,omitempty"`
+	StatusPage *StatusPageReference    `json:"status_page,omitempty"`
+	Title      string                  `json:"title,omitempty"`
+	StartsAt   string                  `json:"starts_at,omitempty"`
+	EndsAt     string                  `json:"ends_at,omitempty"`
+	Updates    []*StatusPagePostUpdate `json:"updates,omitempty"`
+}
+
+// StatusPagePostUpdate represents an update published on a status page post.
+type StatusPagePostUpdate struct {
+	ID                string                           `json:"id,omitempty"`
+	Type              string                           `json:"type,omitempty"`
+	Self              string                           `json:"self,omitempty"`
+	Post              *StatusPagePostReference         `json:"post,omitempty"`
+	Message           string                           `json:"message,omitempty"`
+	ReviewedStatus    string                           `json:"reviewed_status,omitempty"`
+	Status            *StatusPageStatusReference       `json:"status,omitempty"`
+	Severity          *StatusPageSeverityReference     `json:"severity,omitempty"`
+	ImpactedServices  []*StatusPagePostImpactedService `json:"impacted_services,omitempty"`
+	UpdateFrequencyMS *int                             `json:"update_frequency_ms,omitempty"`
+	NotifySubscribers bool                             `json:"notify_subscribers,omitempty"`
+	ReportedAt        string                           `json:"reported_at,omitempty"`
+}
+
+// StatusPagePostImpactedService represents a component impacted by a post
+// update and the severity of the impact.
+type StatusPagePostImpactedService struct {
+	Service  *StatusPageComponentReference `json:"service,omitempty"`
+	Severity *StatusPageSeverityReference  `json:"severity,omitempty"`
+}
+
+// StatusPageComponent represents a component of a status page, backed by a
+// business service.
+type StatusPageComponent struct {
+	ID              string                    `json:"id,omitempty"`
+	Type            string                    `json:"type,omitempty"`
+	Self            string                    `json:"self,omitempty"`
+	Name            string                    `json:"name,omitempty"`
+	StatusPage      *StatusPageReference      `json:"status_page,omitempty"`
+	BusinessService *BusinessServiceReference `json:"business_service,omitempty"`
+}
+
+// StatusPageSeverity represents a severity that can be set on post updates.
+type StatusPageSeverity struct {
+	ID          string               `json:"id,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Self        string               `json:"self,omitempty"`
+	Description string               `json:"description,omitempty"`
+	PostType    string               `json:"post_type,omitempty"`
+	StatusPage  *StatusPageReference `json:"status_page,omitempty"`
+}
+
+// StatusPageStatus represents a status that can be set on post updates.
+type StatusPageStatus struct {
+	ID          string               `json:"id,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Self        string               `json:"self,omitempty"`
+	Description string               `json:"description,omitempty"`
+	PostType    string               `json:"post_type,omitempty"`
+	StatusPage  *StatusPageReference `json:"status_page,omitempty"`
+}
+
+// ListStatusPagesOptions represents options when listing status pages.
+type ListStatusPagesOptions struct {
+	Limit          int    `url:"limit,omitempty"`
+	Offset         int    `url:"offset,omitempty"`
+	StatusPageType string `url:"status_page_type,omitempty"`
+}
+
+type listStatusPagesOptionsGen struct {
+	options *ListStatusPagesOptions
+}
+
+func (o *listStatusPagesOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listStatusPagesOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listStatusPagesOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListStatusPagesResponse represents a list response of status pages.
+type ListStatusPagesResponse struct {
+	StatusPages []*StatusPage `json:"status_pages,omitempty"`
+	Limit       int           `json:"limit,omitempty"`
+	Offset      int           `json:"offset,omitempty"`
+	More        bool          `json:"more,omitempty"`
+	Total       int           `json:"total,omitempty"`
+}
+
+// ListStatusPagePostsOptions represents options when listing status page posts.
+type ListStatusPagePostsOptions struct {
+	PostType       string   `url:"post_type,omitempty"`
+	ReviewedStatus string   `url:"reviewed_status,omitempty"`
+	Statuses       []string `url:"status,omitempty,brackets"`
+}
+
+// ListStatusPagePostsResponse represents a list response of status page posts.
+type ListStatusPagePostsResponse struct {
+	Posts []*StatusPagePost `json:"posts,omitempty"`
+}
+
+// StatusPagePostPayload represents payload with a status page post object.
+type StatusPagePostPayload struct {
+	Post *StatusPagePost `json:"post,omitempty"`
+}
+
+// ListStatusPagePostUpdatesResponse represents a list response of status page
+// post updates.
+type ListStatusPagePostUpdatesResponse struct {
+	PostUpdates []*StatusPagePostUpdate `json:"post_updates,omitempty"`
+}
+
+// StatusPagePostUpdatePayload represents payload with a status page post
+// update object.
+type StatusPagePostUpdatePayload struct {
+	PostUpdate *StatusPagePostUpdate `json:"post_update,omitempty"`
+}
+
+// ListStatusPageComponentsResponse represents a list response of status page
+// components.
+type ListStatusPageComponentsResponse struct {
+	Components []*StatusPageComponent `json:"services,omitempty"`
+}
+
+// ListStatusPagePostTypeOptions represents options when listing status page
+// severities and statuses.
+type ListStatusPagePostTypeOptions struct {
+	PostType string `url:"post_type,omitempty"`
+}
+
+// ListStatusPageSeveritiesResponse represents a list response of status page
+// severities.
+type ListStatusPageSeveritiesResponse struct {
+	Severities []*StatusPageSeverity `json:"severities,omitempty"`
+}
+
+// ListStatusPageStatusesResponse represents a list response of status page
+// statuses.
+type ListStatusPageStatusesResponse struct {
+	Statuses []*StatusPageStatus `json:"statuses,omitempty"`
+}
+
+// List lists existing status pages. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of status pages will be returned.
+func (s *StatusPageService) List(o *ListStatusPagesOptions) (*ListStatusPagesResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing status pages. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of status pages will be returned.
+func (s *StatusPageService) ListContext(ctx context.Context, o *ListStatusPagesOptions) (*ListStatusPagesResponse, *Response, error) {
+	u := "/status_pages"
+	v := new(ListStatusPagesResponse)
+
+	if o == nil {
+		o = &ListStatusPagesOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	statusPages := make([]*StatusPage, 0)
+
+	// Create a handler closure capable of parsing data from the status pages endpoint
+	// and appending resultant status pages to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListStatusPagesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		statusPages = append(statusPages, result.StatusPages...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listStatusPagesOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.StatusPages = statusPages
+
+	return v, nil, nil
+}
+
+// ListPosts lists the posts of a status page.
+func (s *StatusPageService) ListPosts(statusPageID string, o *ListStatusPagePostsOptions) (*ListStatusPagePostsResponse, *Response, error) {
+	return s.ListPostsContext(context.Background(), statusPageID, o)
+}
+
+// ListPostsContext lists the posts of a status page.
+func (s *StatusPageService) ListPostsContext(ctx context.Context, statusPageID string, o *ListStatusPagePostsOptions) (*ListStatusPagePostsResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts", statusPageID)
+	v := new(ListStatusPagePostsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetPost gets a post of a status page.
+func (s *StatusPageService) GetPost(statusPageID, postID string) (*StatusPagePost, *Response, error) {
+	return s.GetPostContext(context.Background(), statusPageID, postID)
+}
+
+// GetPostContext gets a post of a status page.
+func (s *StatusPageService) GetPostContext(ctx context.Context, statusPageID, postID string) (*StatusPagePost, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s", statusPageID, postID)
+	v := new(StatusPagePostPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Post, resp, nil
+}
+
+// CreatePost creates a new post on a status page. The post must carry its
+// initial update in Updates.
+func (s *StatusPageService) CreatePost(statusPageID string, post *StatusPagePost) (*StatusPagePost, *Response, error) {
+	return s.CreatePostContext(context.Background(), statusPageID, post)
+}
+
+// CreatePostContext creates a new post on a status page. The post must carry
+// its initial update in Updates.
+func (s *StatusPageService) CreatePostContext(ctx context.Context, statusPageID string, post *StatusPagePost) (*StatusPagePost, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts", statusPageID)
+	v := new(StatusPagePostPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &StatusPagePostPayload{Post: post}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Post, resp, nil
+}
+
+// UpdatePost updates a post of a status page.
+func (s *StatusPageService) UpdatePost(statusPageID, postID string, post *StatusPagePost) (*StatusPagePost, *Response, error) {
+	return s.UpdatePostContext(context.Background(), statusPageID, postID, post)
+}
+
+// UpdatePostContext updates a post of a status page.
+func (s *StatusPageService) UpdatePostContext(ctx context.Context, statusPageID, postID string, post *StatusPagePost) (*StatusPagePost, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s", statusPageID, postID)
+	v := new(StatusPagePostPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &StatusPagePostPayload{Post: post}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Post, resp, nil
+}
+
+// DeletePost deletes a post of a status page.
+func (s *StatusPageService) DeletePost(statusPageID, postID string) (*Response, error) {
+	return s.DeletePostContext(context.Background(), statusPageID, postID)
+}
+
+// DeletePostContext deletes a post of a status page.
+func (s *StatusPageService) DeletePostContext(ctx context.Context, statusPageID, postID string) (*Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s", statusPageID, postID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// ListPostUpdates lists the updates of a status page post.
+func (s *StatusPageService) ListPostUpdates(statusPageID, postID string) (*ListStatusPagePostUpdatesResponse, *Response, error) {
+	return s.ListPostUpdatesContext(context.Background(), statusPageID, postID)
+}
+
+// ListPostUpdatesContext lists the updates of a status page post.
+func (s *StatusPageService) ListPostUpdatesContext(ctx context.Context, statusPageID, postID string) (*ListStatusPagePostUpdatesResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates", statusPageID, postID)
+	v := new(ListStatusPagePostUpdatesResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// CreatePostUpdate publishes a new update on a status page post.
+func (s *StatusPageService) CreatePostUpdate(statusPageID, postID string, update *StatusPagePostUpdate) (*StatusPagePostUpdate, *Response, error) {
+	return s.CreatePostUpdateContext(context.Background(), statusPageID, postID, update)
+}
+
+// CreatePostUpdateContext publishes a new update on a status page post.
+func (s *StatusPageService) CreatePostUpdateContext(ctx context.Context, statusPageID, postID string, update *StatusPagePostUpdate) (*StatusPagePostUpdate, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates", statusPageID, postID)
+	v := new(StatusPagePostUpdatePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &StatusPagePostUpdatePayload{PostUpdate: update}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.PostUpdate, resp, nil
+}
+
+// UpdatePostUpdate updates an update of a status page post.
+func (s *StatusPageService) UpdatePostUpdate(statusPageID, postID, postUpdateID string, update *StatusPagePostUpdate) (*StatusPagePostUpdate, *Response, error) {
+	return s.UpdatePostUpdateContext(context.Background(), statusPageID, postID, postUpdateID, update)
+}
+
+// UpdatePostUpdateContext updates an update of a status page post.
+func (s *StatusPageService) UpdatePostUpdateContext(ctx context.Context, statusPageID, postID, postUpdateID string, update *StatusPagePostUpdate) (*StatusPagePostUpdate, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates/%s", statusPageID, postID, postUpdateID)
+	v := new(StatusPagePostUpdatePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &StatusPagePostUpdatePayload{PostUpdate: update}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.PostUpdate, resp, nil
+}
+
+// DeletePostUpdate deletes an update of a status page post.
+func (s *StatusPageService) DeletePostUpdate(statusPageID, postID, postUpdateID string) (*Response, error) {
+	return s.DeletePostUpdateContext(context.Background(), statusPageID, postID, postUpdateID)
+}
+
+// DeletePostUpdateContext deletes an update of a status page post.
+func (s *StatusPageService) DeletePostUpdateContext(ctx context.Context, statusPageID, postID, postUpdateID string) (*Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/posts/%s/post_updates/%s", statusPageID, postID, postUpdateID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// ListComponents lists the components of a status page.
+func (s *StatusPageService) ListComponents(statusPageID string) (*ListStatusPageComponentsResponse, *Response, error) {
+	return s.ListComponentsContext(context.Background(), statusPageID)
+}
+
+// ListComponentsContext lists the components of a status page.
+func (s *StatusPageService) ListComponentsContext(ctx context.Context, statusPageID string) (*ListStatusPageComponentsResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/services", statusPageID)
+	v := new(ListStatusPageComponentsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// ListSeverities lists the severities available on a status page.
+func (s *StatusPageService) ListSeverities(statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageSeveritiesResponse, *Response, error) {
+	return s.ListSeveritiesContext(context.Background(), statusPageID, o)
+}
+
+// ListSeveritiesContext lists the severities available on a status page.
+func (s *StatusPageService) ListSeveritiesContext(ctx context.Context, statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageSeveritiesResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/severities", statusPageID)
+	v := new(ListStatusPageSeveritiesResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// ListStatuses lists the statuses available on a status page.
+func (s *StatusPageService) ListStatuses(statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageStatusesResponse, *Response, error) {
+	return s.ListStatusesContext(context.Background(), statusPageID, o)
+}
+
+// ListStatusesContext lists the statuses available on a status page.
+func (s *StatusPageService) ListStatusesContext(ctx context.Context, statusPageID string, o *ListStatusPagePostTypeOptions) (*ListStatusPageStatusesResponse, *Response, error) {
+	u := fmt.Sprintf("/status_pages/%s/statuses", statusPageID)
+	v := new(ListStatusPageStatusesResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,253 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestStatusPagesList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/status_pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"status_pages": [{"id": "PSP1", "name": "Public", "status_page_type": "public"}], "limit": 1, "offset": 0, "more": true}`))
+		default:
+			w.Write([]byte(`{"status_pages": [{"id": "PSP2", "name": "Private", "status_page_type": "private"}], "limit": 1, "offset": 1, "more": false}`))
+		}
+	})
+
+	resp, _, err := client.StatusPages.List(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListStatusPagesResponse{
+		StatusPages: []*StatusPage{
+			{ID: "PSP1", Name: "Public", StatusPageType: "public"},
+			{ID: "PSP2", Name: "Private", StatusPageType: "private"},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestStatusPagesListPosts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/status_pages/PSP1/posts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "post_type", "incident")
+		w.Write([]byte(`{"posts": [{"id": "PPOST1", "post_type": "incident", "title": "Outage"}]}`))
+	})
+
+	resp, _, err := client.StatusPages.ListPosts("PSP1", &ListStatusPagePostsOptions{PostType: "incident"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListStatusPagePostsResponse{
+		Posts: []*StatusPagePost{{ID: "PPOST1", PostType: "incident", Title: "Outage"}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestStatusPagesCreatePost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &StatusPagePost{
+		Type:     "status_page_post",
+		PostType: "incident",
+		Title:    "Outage",
+		Updates: []*StatusPagePostUpdate{
+			{
+				Message:  "Investigating",
+				Status:   &StatusPageStatusReference{ID: "PSTAT1", Type: "status_page_status"},
+				Severity: &StatusPageSeverityReference{ID: "PSEV1", Type: "status_page_severity"},
+				ImpactedServices: []*StatusPagePostImpactedService{
+					{
+						Service:  &StatusPageComponentReference{ID: "PCOMP1", Type: "status_page_service"},
+						Severity: &StatusPageSeverityReference{ID: "PSEV1", Type: "status_page_severity"},
+					},
+				},
+			},
+		},
+	}
+
+	mux.HandleFunc("/status_pages/PSP1/posts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(StatusPagePostPayload)
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Post, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Post, input)
+		}
+		w.Write([]byte(`{"post": {"id": "PPOST1", "post_type": "incident", "title": "Outage"}}`))
+	})
+
+	resp, _, err := client.StatusPages.CreatePost("PSP1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &StatusPagePost{ID: "PPOST1", PostType: "incident", Title: "Outage"}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestStatusPagesPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/status_pages/PSP1/posts/PPOST1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"post": {"id": "PPOST1", "title": "Outage"}}`))
+		case "PUT":
+			testBody(t, r, `{"post":{"title":"Resolved outage"}}`)
+			w.Write([]byte(`{"post": {"id": "PPOST1", "title": "Resolved outage"}}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	post, _, err := client.StatusPages.GetPost("PSP1", "PPOST1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&StatusPagePost{ID: "PPOST1", Title: "Outage"}); !reflect.DeepEqual(post, want) {
+		t.Errorf("returned %#v; want %#v", post, want)
+	}
+
+	post, _, err = client.StatusPages.UpdatePost("PSP1", "PPOST1", &StatusPagePost{Title: "Resolved outage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&StatusPagePost{ID: "PPOST1", Title: "Resolved outage"}); !reflect.DeepEqual(post, want) {
+		t.Errorf("returned %#v; want %#v", post, want)
+	}
+
+	if _, err := client.StatusPages.DeletePost("PSP1", "PPOST1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatusPagesPostUpdates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/status_pages/PSP1/posts/PPOST1/post_updates", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"post_updates": [{"id": "PUPD1", "message": "Investigating"}]}`))
+		case "POST":
+			testBody(t, r, `{"post_update":{"message":"Fixed","notify_subscribers":true}}`)
+			w.Write([]byte(`{"post_update": {"id": "PUPD2", "message": "Fixed", "notify_subscribers": true}}`))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/status_pages/PSP1/posts/PPOST1/post_updates/PUPD2", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			testBody(t, r, `{"post_update":{"message":"Fixed for good"}}`)
+			w.Write([]byte(`{"post_update": {"id": "PUPD2", "message": "Fixed for good"}}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	list, _, err := client.StatusPages.ListPostUpdates("PSP1", "PPOST1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*StatusPagePostUpdate{{ID: "PUPD1", Message: "Investigating"}}; !reflect.DeepEqual(list.PostUpdates, want) {
+		t.Errorf("returned %#v; want %#v", list.PostUpdates, want)
+	}
+
+	update, _, err := client.StatusPages.CreatePostUpdate("PSP1", "PPOST1", &StatusPagePostUpdate{Message: "Fixed", NotifySubscribers: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&StatusPagePostUpdate{ID: "PUPD2", Message: "Fixed", NotifySubscribers: true}); !reflect.DeepEqual(update, want) {
+		t.Errorf("returned %#v; want %#v", update, want)
+	}
+
+	update, _, err = client.StatusPages.UpdatePostUpdate("PSP1", "PPOST1", "PUPD2", &StatusPagePostUpdate{Message: "Fixed for good"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&StatusPagePostUpdate{ID: "PUPD2", Message: "Fixed for good"}); !reflect.DeepEqual(update, want) {
+		t.Errorf("returned %#v; want %#v", update, want)
+	}
+
+	if _, err := client.StatusPages.DeletePostUpdate("PSP1", "PPOST1", "PUPD2"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStatusPagesListComponentsSeveritiesAndStatuses(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/status_pages/PSP1/services", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"services": [{"id": "PCOMP1", "name": "API", "business_service": {"id": "PBS1", "type": "business_service_reference"}}]}`))
+	})
+	mux.HandleFunc("/status_pages/PSP1/severities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "post_type", "incident")
+		w.Write([]byte(`{"severities": [{"id": "PSEV1", "description": "major", "post_type": "incident"}]}`))
+	})
+	mux.HandleFunc("/status_pages/PSP1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"statuses": [{"id": "PSTAT1", "description": "investigating", "post_type": "incident"}]}`))
+	})
+
+	components, _, err := client.StatusPages.ListComponents("PSP1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantComponents := []*StatusPageComponent{
+		{ID: "PCOMP1", Name: "API", BusinessService: &BusinessServiceReference{ID: "PBS1", Type: "business_service_reference"}},
+	}
+	if !reflect.DeepEqual(components.Components, wantComponents) {
+		t.Errorf("returned %#v; want %#v", components.Components, wantComponents)
+	}
+
+	o := &ListStatusPagePostTypeOptions{PostType: "incident"}
+
+	severities, _, err := client.StatusPages.ListSeverities("PSP1", o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSeverities := []*StatusPageSeverity{{ID: "PSEV1", Description: "major", PostType: "incident"}}
+	if !reflect.DeepEqual(severities.Severities, wantSeverities) {
+		t.Errorf("returned %#v; want %#v", severities.Severities, wantSeverities)
+	}
+
+	statuses, _, err := client.StatusPages.ListStatuses("PSP1", o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStatuses := []*StatusPageStatus{{ID: "PSTAT1", Description: "investigating", PostType: "incident"}}
+	if !reflect.DeepEqual(statuses.Statuses, wantStatuses) {
+		t.Errorf("returned %#v; want %#v", statuses.Statuses, wantStatuses)
+	}
+}
@@ -0,0 +1,268 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// TemplateService handles the communication with notification template
+// related methods of the PagerDuty API.
+type TemplateService service
+
+// TemplateTypeStatusUpdate is the type of templates used for incident status
+// updates.
+const TemplateTypeStatusUpdate = "status_update"
+
+// Template represents a notification template.
+type Template struct {
+	ID              string                   `json:"id,omitempty"`
+	Type            string                   `json:"type,omitempty"`
+	Self            string                   `json:"self,omitempty"`
+	HTMLURL         string                   `json:"html_url,omitempty"`
+	Summary         string                   `json:"summary,omitempty"`
+	Name            string                   `json:"name,omitempty"`
+	Description     string                   `json:"description,omitempty"`
+	TemplateType    string                   `json:"template_type,omitempty"`
+	TemplatedFields *TemplateTemplatedFields `json:"templated_fields,omitempty"`
+	CreatedAt       string                   `json:"created_at,omitempty"`
+	CreatedBy       *UserReference           `json:"created_by,omitempty"`
+	UpdatedAt       string                   `json:"updated_at,omitempty"`
+	UpdatedBy       *UserReference           `json:"updated_by,omitempty"`
+}
+
+// TemplateTemplatedFields represents the templated content of a status
+// update sent by email and as a short message.
+type TemplateTemplatedFields struct {
+	EmailSubject string `json:"email_subject,omitempty"`
+	EmailBody    string `json:"email_body,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// TemplatePayload represents payload with a template object.
+type TemplatePayload struct {
+	Template *Template `json:"template,omitempty"`
+}
+
+// ListTemplatesOptions represents options when listing templates.
+type ListTemplatesOptions struct {
+	Limit        int    `url:"limit,omitempty"`
+	Offset       int    `url:"offset,omitempty"`
+	Total        bool   `url:"total,omitempty"`
+	Query        string `url:"query,omitempty"`
+	TemplateType string `url:"template_type,omitempty"`
+	SortBy       string `url:"sort_by,omitempty"`
+}
+
+type listTemplatesOptionsGen struct {
+	options *ListTemplatesOptions
+}
+
+func (o *listTemplatesOptionsGen) currentOffset() int {
+	return o.options.Offset
+}
+
+func (o *listTemplatesOptionsGen) changeOffset(i int) {
+	o.options.Offset = i
+}
+
+func (o *listTemplatesOptionsGen) buildStruct() interface{} {
+	return o.options
+}
+
+// ListTemplatesResponse represents a list response of templates.
+type ListTemplatesResponse struct {
+	Templates []*Template `json:"templates,omitempty"`
+	Limit     int         `json:"limit,omitempty"`
+	Offset    int         `json:"offset,omitempty"`
+	More      bool        `json:"more,omitempty"`
+	Total     int         `json:"total,omitempty"`
+}
+
+// TemplateField represents a field that can be referenced in templates.
+type TemplateField struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ListTemplateFieldsResponse represents a list response of template fields.
+type ListTemplateFieldsResponse struct {
+	Fields []*TemplateField `json:"fields,omitempty"`
+}
+
+// RenderTemplateRequest represents the context a template is rendered with.
+type RenderTemplateRequest struct {
+	IncidentID   string                      `json:"incident_id"`
+	StatusUpdate *RenderTemplateStatusUpdate `json:"status_update,omitempty"`
+	External     map[string]interface{}      `json:"external,omitempty"`
+}
+
+// RenderTemplateStatusUpdate represents the status update message a
+// template is rendered with.
+type RenderTemplateStatusUpdate struct {
+	Message string `json:"message,omitempty"`
+}
+
+// RenderedTemplate represents a template rendered against an incident.
+type RenderedTemplate struct {
+	TemplatedFields *TemplateTemplatedFields `json:"templated_fields,omitempty"`
+	Warnings        []string                 `json:"warnings,omitempty"`
+	Errors          []string                 `json:"errors,omitempty"`
+}
+
+// List lists existing templates. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of templates will be returned.
+func (s *TemplateService) List(o *ListTemplatesOptions) (*ListTemplatesResponse, *Response, error) {
+	return s.ListContext(context.Background(), o)
+}
+
+// ListContext lists existing templates. If a non-zero Limit is passed as an option, only a single page of results will be
+// returned. Otherwise, the entire list of templates will be returned.
+func (s *TemplateService) ListContext(ctx context.Context, o *ListTemplatesOptions) (*ListTemplatesResponse, *Response, error) {
+	u := "/templates"
+	v := new(ListTemplatesResponse)
+
+	if o == nil {
+		o = &ListTemplatesOptions{}
+	}
+
+	if o.Limit != 0 {
+		resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return v, resp, nil
+	}
+
+	templates := make([]*Template, 0)
+
+	// Create a handler closure capable of parsing data from the templates endpoint
+	// and appending resultant templates to the return slice.
+	responseHandler := func(response *Response) (ListResp, *Response, error) {
+		var result ListTemplatesResponse
+
+		if err := s.client.DecodeJSON(response, &result); err != nil {
+			return ListResp{}, response, err
+		}
+
+		templates = append(templates, result.Templates...)
+
+		// Return stats on the current page. Caller can use this information to
+		// adjust for requesting additional pages.
+		return ListResp{
+			More:   result.More,
+			Offset: result.Offset,
+			Limit:  result.Limit,
+		}, response, nil
+	}
+	err := s.client.newRequestPagedGetQueryDoContext(ctx, u, responseHandler, &listTemplatesOptionsGen{
+		options: o,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	v.Templates = templates
+
+	return v, nil, nil
+}
+
+// Get gets a template.
+func (s *TemplateService) Get(id string) (*Template, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext gets a template.
+func (s *TemplateService) GetContext(ctx context.Context, id string) (*Template, *Response, error) {
+	u := fmt.Sprintf("/templates/%s", id)
+	v := new(TemplatePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Template, resp, nil
+}
+
+// Create creates a new template.
+func (s *TemplateService) Create(template *Template) (*Template, *Response, error) {
+	return s.CreateContext(context.Background(), template)
+}
+
+// CreateContext creates a new template.
+func (s *TemplateService) CreateContext(ctx context.Context, template *Template) (*Template, *Response, error) {
+	u := "/templates"
+	v := new(TemplatePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &TemplatePayload{Template: template}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Template, resp, nil
+}
+
+// Update updates an existing template.
+func (s *TemplateService) Update(id string, template *Template) (*Template, *Response, error) {
+	return s.UpdateContext(context.Background(), id, template)
+}
+
+// UpdateContext updates an existing template.
+func (s *TemplateService) UpdateContext(ctx context.Context, id string, template *Template) (*Template, *Response, error) {
+	u := fmt.Sprintf("/templates/%s", id)
+	v := new(TemplatePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &TemplatePayload{Template: template}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Template, resp, nil
+}
+
+// Delete removes an existing template.
+func (s *TemplateService) Delete(id string) (*Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext removes an existing template.
+func (s *TemplateService) DeleteContext(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("/templates/%s", id)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// ListFields lists the fields that can be referenced in templates.
+func (s *TemplateService) ListFields() (*ListTemplateFieldsResponse, *Response, error) {
+	return s.ListFieldsContext(context.Background())
+}
+
+// ListFieldsContext lists the fields that can be referenced in templates.
+func (s *TemplateService) ListFieldsContext(ctx context.Context) (*ListTemplateFieldsResponse, *Response, error) {
+	u := "/templates/fields"
+	v := new(ListTemplateFieldsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// Render renders a template against an incident.
+func (s *TemplateService) Render(id string, r *RenderTemplateRequest) (*RenderedTemplate, *Response, error) {
+	return s.RenderContext(context.Background(), id, r)
+}
+
+// RenderContext renders a template against an incident.
+func (s *TemplateService) RenderContext(ctx context.Context, id string, r *RenderTemplateRequest) (*RenderedTemplate, *Response, error) {
+	u := fmt.Sprintf("/templates/%s/render", id)
+	v := new(RenderedTemplate)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, r, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
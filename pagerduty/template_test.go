@@ -0,0 +1,168 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTemplatesList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "template_type", "status_update")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"templates": [{"id": "PTMPL1", "name": "Outage"}], "limit": 1, "offset": 0, "more": true}`))
+		default:
+			w.Write([]byte(`{"templates": [{"id": "PTMPL2", "name": "Maintenance"}], "limit": 1, "offset": 1, "more": false}`))
+		}
+	})
+
+	resp, _, err := client.Templates.List(&ListTemplatesOptions{TemplateType: TemplateTypeStatusUpdate})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListTemplatesResponse{
+		Templates: []*Template{
+			{ID: "PTMPL1", Name: "Outage"},
+			{ID: "PTMPL2", Name: "Maintenance"},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestTemplatesCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &Template{
+		Name:         "Outage",
+		TemplateType: TemplateTypeStatusUpdate,
+		TemplatedFields: &TemplateTemplatedFields{
+			EmailSubject: "{{incident.title}}",
+			EmailBody:    "<p>{{status_update.message}}</p>",
+			Message:      "{{status_update.message}}",
+		},
+	}
+
+	mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(TemplatePayload)
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v.Template, input) {
+			t.Errorf("Request body = %+v, want %+v", v.Template, input)
+		}
+		w.Write([]byte(`{"template": {"id": "PTMPL1", "name": "Outage", "template_type": "status_update"}}`))
+	})
+
+	resp, _, err := client.Templates.Create(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Template{ID: "PTMPL1", Name: "Outage", TemplateType: TemplateTypeStatusUpdate}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestTemplatesGetUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/PTMPL1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"template": {"id": "PTMPL1", "name": "Outage", "templated_fields": {"message": "hi"}}}`))
+		case "PUT":
+			testBody(t, r, `{"template":{"name":"Major outage"}}`)
+			w.Write([]byte(`{"template": {"id": "PTMPL1", "name": "Major outage"}}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	template, _, err := client.Templates.Get("PTMPL1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Template{ID: "PTMPL1", Name: "Outage", TemplatedFields: &TemplateTemplatedFields{Message: "hi"}}
+	if !reflect.DeepEqual(template, want) {
+		t.Errorf("returned %#v; want %#v", template, want)
+	}
+
+	template, _, err = client.Templates.Update("PTMPL1", &Template{Name: "Major outage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = &Template{ID: "PTMPL1", Name: "Major outage"}
+	if !reflect.DeepEqual(template, want) {
+		t.Errorf("returned %#v; want %#v", template, want)
+	}
+
+	if _, err := client.Templates.Delete("PTMPL1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemplatesListFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"fields": [{"name": "incident.title", "description": "The incident title"}]}`))
+	})
+
+	resp, _, err := client.Templates.ListFields()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListTemplateFieldsResponse{
+		Fields: []*TemplateField{{Name: "incident.title", Description: "The incident title"}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestTemplatesRender(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/PTMPL1/render", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"incident_id":"PINC1","status_update":{"message":"Fixed"}}`)
+		w.Write([]byte(`{"templated_fields": {"email_subject": "Database outage", "message": "Fixed"}, "warnings": ["unknown field"]}`))
+	})
+
+	resp, _, err := client.Templates.Render("PTMPL1", &RenderTemplateRequest{
+		IncidentID:   "PINC1",
+		StatusUpdate: &RenderTemplateStatusUpdate{Message: "Fixed"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &RenderedTemplate{
+		TemplatedFields: &TemplateTemplatedFields{EmailSubject: "Database outage", Message: "Fixed"},
+		Warnings:        []string{"unknown field"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
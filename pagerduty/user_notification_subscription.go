@@ -0,0 +1,109 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// NotificationSubscription represents a subscription of a user to the
+// status updates of an incident or business service.
+type NotificationSubscription struct {
+	SubscriberID     string `json:"subscriber_id,omitempty"`
+	SubscriberType   string `json:"subscriber_type,omitempty"`
+	SubscribableID   string `json:"subscribable_id,omitempty"`
+	SubscribableType string `json:"subscribable_type,omitempty"`
+	AccountID        string `json:"account_id,omitempty"`
+	Result           string `json:"result,omitempty"`
+}
+
+// NotificationSubscribable represents an entity a user can subscribe to.
+type NotificationSubscribable struct {
+	SubscribableID   string `json:"subscribable_id"`
+	SubscribableType string `json:"subscribable_type"`
+}
+
+// NotificationSubscribablesPayload represents a list of entities to
+// subscribe to or unsubscribe from.
+type NotificationSubscribablesPayload struct {
+	Subscribables []*NotificationSubscribable `json:"subscribables"`
+}
+
+// ListNotificationSubscriptionsOptions represents options when listing the
+// notification subscriptions of a user.
+type ListNotificationSubscriptionsOptions struct {
+	Limit  int  `url:"limit,omitempty"`
+	Offset int  `url:"offset,omitempty"`
+	Total  bool `url:"total,omitempty"`
+}
+
+// ListNotificationSubscriptionsResponse represents a list response of
+// notification subscriptions.
+type ListNotificationSubscriptionsResponse struct {
+	Subscriptions []*NotificationSubscription `json:"subscriptions,omitempty"`
+	Limit         int                         `json:"limit,omitempty"`
+	Offset        int                         `json:"offset,omitempty"`
+	More          bool                        `json:"more,omitempty"`
+	Total         int                         `json:"total,omitempty"`
+}
+
+// UnsubscribeNotificationSubscriptionsResponse represents the result of
+// removing notification subscriptions.
+type UnsubscribeNotificationSubscriptionsResponse struct {
+	DeletedCount      int `json:"deleted_count"`
+	UnauthorizedCount int `json:"unauthorized_count"`
+	NonExistentCount  int `json:"non_existent_count"`
+}
+
+// ListNotificationSubscriptions lists the notification subscriptions of a user.
+func (s *UserService) ListNotificationSubscriptions(userID string, o *ListNotificationSubscriptionsOptions) (*ListNotificationSubscriptionsResponse, *Response, error) {
+	return s.ListNotificationSubscriptionsContext(context.Background(), userID, o)
+}
+
+// ListNotificationSubscriptionsContext lists the notification subscriptions of a user.
+func (s *UserService) ListNotificationSubscriptionsContext(ctx context.Context, userID string, o *ListNotificationSubscriptionsOptions) (*ListNotificationSubscriptionsResponse, *Response, error) {
+	u := fmt.Sprintf("/users/%s/notification_subscriptions", userID)
+	v := new(ListNotificationSubscriptionsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, o, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// CreateNotificationSubscriptions subscribes a user to the given entities.
+func (s *UserService) CreateNotificationSubscriptions(userID string, subscribables []*NotificationSubscribable) ([]*NotificationSubscription, *Response, error) {
+	return s.CreateNotificationSubscriptionsContext(context.Background(), userID, subscribables)
+}
+
+// CreateNotificationSubscriptionsContext subscribes a user to the given entities.
+func (s *UserService) CreateNotificationSubscriptionsContext(ctx context.Context, userID string, subscribables []*NotificationSubscribable) ([]*NotificationSubscription, *Response, error) {
+	u := fmt.Sprintf("/users/%s/notification_subscriptions", userID)
+	v := new(ListNotificationSubscriptionsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &NotificationSubscribablesPayload{Subscribables: subscribables}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.Subscriptions, resp, nil
+}
+
+// DeleteNotificationSubscriptions unsubscribes a user from the given entities.
+func (s *UserService) DeleteNotificationSubscriptions(userID string, subscribables []*NotificationSubscribable) (*UnsubscribeNotificationSubscriptionsResponse, *Response, error) {
+	return s.DeleteNotificationSubscriptionsContext(context.Background(), userID, subscribables)
+}
+
+// DeleteNotificationSubscriptionsContext unsubscribes a user from the given entities.
+func (s *UserService) DeleteNotificationSubscriptionsContext(ctx context.Context, userID string, subscribables []*NotificationSubscribable) (*UnsubscribeNotificationSubscriptionsResponse, *Response, error) {
+	u := fmt.Sprintf("/users/%s/notification_subscriptions/unsubscribe", userID)
+	v := new(UnsubscribeNotificationSubscriptionsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &NotificationSubscribablesPayload{Subscribables: subscribables}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
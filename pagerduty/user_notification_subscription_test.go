@@ -0,0 +1,84 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsersListNotificationSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PUSER1/notification_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryValue(t, r, "limit", "10")
+		w.Write([]byte(`{"subscriptions": [{"subscriber_id": "PUSER1", "subscriber_type": "user", "subscribable_id": "PBS1", "subscribable_type": "business_service", "account_id": "PACCT1"}], "limit": 10, "more": false}`))
+	})
+
+	resp, _, err := client.Users.ListNotificationSubscriptions("PUSER1", &ListNotificationSubscriptionsOptions{Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListNotificationSubscriptionsResponse{
+		Subscriptions: []*NotificationSubscription{
+			{SubscriberID: "PUSER1", SubscriberType: "user", SubscribableID: "PBS1", SubscribableType: "business_service", AccountID: "PACCT1"},
+		},
+		Limit: 10,
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersCreateNotificationSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PUSER1/notification_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"subscribables":[{"subscribable_id":"PINC1","subscribable_type":"incident"}]}`)
+		w.Write([]byte(`{"subscriptions": [{"subscriber_id": "PUSER1", "subscriber_type": "user", "subscribable_id": "PINC1", "subscribable_type": "incident", "result": "success"}]}`))
+	})
+
+	resp, _, err := client.Users.CreateNotificationSubscriptions("PUSER1", []*NotificationSubscribable{
+		{SubscribableID: "PINC1", SubscribableType: "incident"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*NotificationSubscription{
+		{SubscriberID: "PUSER1", SubscriberType: "user", SubscribableID: "PINC1", SubscribableType: "incident", Result: "success"},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersDeleteNotificationSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PUSER1/notification_subscriptions/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"subscribables":[{"subscribable_id":"PINC1","subscribable_type":"incident"}]}`)
+		w.Write([]byte(`{"deleted_count": 1, "unauthorized_count": 0, "non_existent_count": 0}`))
+	})
+
+	resp, _, err := client.Users.DeleteNotificationSubscriptions("PUSER1", []*NotificationSubscribable{
+		{SubscribableID: "PINC1", SubscribableType: "incident"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &UnsubscribeNotificationSubscriptionsResponse{DeletedCount: 1}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
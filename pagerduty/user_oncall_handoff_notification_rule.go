@@ -0,0 +1,120 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// OncallHandoffNotificationRule represents a rule notifying a user before
+// they go on or off call.
+type OncallHandoffNotificationRule struct {
+	ID                     string                  `json:"id,omitempty"`
+	NotifyAdvanceInMinutes int                     `json:"notify_advance_in_minutes"`
+	HandoffType            string                  `json:"handoff_type,omitempty"`
+	ContactMethod          *ContactMethodReference `json:"contact_method,omitempty"`
+}
+
+// OncallHandoffNotificationRulePayload represents an on-call handoff
+// notification rule.
+type OncallHandoffNotificationRulePayload struct {
+	OncallHandoffNotificationRule *OncallHandoffNotificationRule `json:"oncall_handoff_notification_rule,omitempty"`
+}
+
+// ListOncallHandoffNotificationRulesResponse represents a list response of
+// on-call handoff notification rules.
+type ListOncallHandoffNotificationRulesResponse struct {
+	OncallHandoffNotificationRules []*OncallHandoffNotificationRule `json:"oncall_handoff_notification_rules,omitempty"`
+}
+
+// ListOncallHandoffNotificationRules lists the on-call handoff notification
+// rules of a user.
+func (s *UserService) ListOncallHandoffNotificationRules(userID string) (*ListOncallHandoffNotificationRulesResponse, *Response, error) {
+	return s.ListOncallHandoffNotificationRulesContext(context.Background(), userID)
+}
+
+// ListOncallHandoffNotificationRulesContext lists the on-call handoff
+// notification rules of a user.
+func (s *UserService) ListOncallHandoffNotificationRulesContext(ctx context.Context, userID string) (*ListOncallHandoffNotificationRulesResponse, *Response, error) {
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules", userID)
+	v := new(ListOncallHandoffNotificationRulesResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// CreateOncallHandoffNotificationRule creates a new on-call handoff
+// notification rule for a user.
+func (s *UserService) CreateOncallHandoffNotificationRule(userID string, rule *OncallHandoffNotificationRule) (*OncallHandoffNotificationRule, *Response, error) {
+	return s.CreateOncallHandoffNotificationRuleContext(context.Background(), userID, rule)
+}
+
+// CreateOncallHandoffNotificationRuleContext creates a new on-call handoff
+// notification rule for a user.
+func (s *UserService) CreateOncallHandoffNotificationRuleContext(ctx context.Context, userID string, rule *OncallHandoffNotificationRule) (*OncallHandoffNotificationRule, *Response, error) {
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules", userID)
+	v := new(OncallHandoffNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "POST", u, nil, &OncallHandoffNotificationRulePayload{OncallHandoffNotificationRule: rule}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.OncallHandoffNotificationRule, resp, nil
+}
+
+// GetOncallHandoffNotificationRule retrieves an on-call handoff notification
+// rule of a user.
+func (s *UserService) GetOncallHandoffNotificationRule(userID, ruleID string) (*OncallHandoffNotificationRule, *Response, error) {
+	return s.GetOncallHandoffNotificationRuleContext(context.Background(), userID, ruleID)
+}
+
+// GetOncallHandoffNotificationRuleContext retrieves an on-call handoff
+// notification rule of a user.
+func (s *UserService) GetOncallHandoffNotificationRuleContext(ctx context.Context, userID, ruleID string) (*OncallHandoffNotificationRule, *Response, error) {
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules/%s", userID, ruleID)
+	v := new(OncallHandoffNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.OncallHandoffNotificationRule, resp, nil
+}
+
+// UpdateOncallHandoffNotificationRule updates an on-call handoff
+// notification rule of a user.
+func (s *UserService) UpdateOncallHandoffNotificationRule(userID, ruleID string, rule *OncallHandoffNotificationRule) (*OncallHandoffNotificationRule, *Response, error) {
+	return s.UpdateOncallHandoffNotificationRuleContext(context.Background(), userID, ruleID, rule)
+}
+
+// UpdateOncallHandoffNotificationRuleContext updates an on-call handoff
+// notification rule of a user.
+func (s *UserService) UpdateOncallHandoffNotificationRuleContext(ctx context.Context, userID, ruleID string, rule *OncallHandoffNotificationRule) (*OncallHandoffNotificationRule, *Response, error) {
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules/%s", userID, ruleID)
+	v := new(OncallHandoffNotificationRulePayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "PUT", u, nil, &OncallHandoffNotificationRulePayload{OncallHandoffNotificationRule: rule}, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.OncallHandoffNotificationRule, resp, nil
+}
+
+// DeleteOncallHandoffNotificationRule deletes an on-call handoff
+// notification rule of a user.
+func (s *UserService) DeleteOncallHandoffNotificationRule(userID, ruleID string) (*Response, error) {
+	return s.DeleteOncallHandoffNotificationRuleContext(context.Background(), userID, ruleID)
+}
+
+// DeleteOncallHandoffNotificationRuleContext deletes an on-call handoff
+// notification rule of a user.
+func (s *UserService) DeleteOncallHandoffNotificationRuleContext(ctx context.Context, userID, ruleID string) (*Response, error) {
+	u := fmt.Sprintf("/users/%s/oncall_handoff_notification_rules/%s", userID, ruleID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
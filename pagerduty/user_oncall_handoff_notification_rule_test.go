@@ -0,0 +1,111 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsersListOncallHandoffNotificationRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PUSER1/oncall_handoff_notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"oncall_handoff_notification_rules": [{"id": "PRULE1", "notify_advance_in_minutes": 60, "handoff_type": "both", "contact_method": {"id": "PCM1", "type": "email_contact_method_reference"}}]}`))
+	})
+
+	resp, _, err := client.Users.ListOncallHandoffNotificationRules("PUSER1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListOncallHandoffNotificationRulesResponse{
+		OncallHandoffNotificationRules: []*OncallHandoffNotificationRule{
+			{
+				ID:                     "PRULE1",
+				NotifyAdvanceInMinutes: 60,
+				HandoffType:            "both",
+				ContactMethod:          &ContactMethodReference{ID: "PCM1", Type: "email_contact_method_reference"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersCreateOncallHandoffNotificationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OncallHandoffNotificationRule{
+		NotifyAdvanceInMinutes: 0,
+		HandoffType:            "oncall",
+		ContactMethod:          &ContactMethodReference{ID: "PCM1", Type: "email_contact_method_reference"},
+	}
+
+	mux.HandleFunc("/users/PUSER1/oncall_handoff_notification_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"oncall_handoff_notification_rule":{"notify_advance_in_minutes":0,"handoff_type":"oncall","contact_method":{"id":"PCM1","type":"email_contact_method_reference"}}}`)
+		w.Write([]byte(`{"oncall_handoff_notification_rule": {"id": "PRULE1", "notify_advance_in_minutes": 0, "handoff_type": "oncall"}}`))
+	})
+
+	resp, _, err := client.Users.CreateOncallHandoffNotificationRule("PUSER1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &OncallHandoffNotificationRule{ID: "PRULE1", HandoffType: "oncall"}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersUpdateOncallHandoffNotificationRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OncallHandoffNotificationRule{NotifyAdvanceInMinutes: 30, HandoffType: "offcall"}
+
+	mux.HandleFunc("/users/PUSER1/oncall_handoff_notification_rules/PRULE1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"oncall_handoff_notification_rule": {"id": "PRULE1", "notify_advance_in_minutes": 60, "handoff_type": "both"}}`))
+		case "PUT":
+			v := new(OncallHandoffNotificationRulePayload)
+			json.NewDecoder(r.Body).Decode(v)
+			if !reflect.DeepEqual(v.OncallHandoffNotificationRule, input) {
+				t.Errorf("Request body = %+v, want %+v", v.OncallHandoffNotificationRule, input)
+			}
+			w.Write([]byte(`{"oncall_handoff_notification_rule": {"id": "PRULE1", "notify_advance_in_minutes": 30, "handoff_type": "offcall"}}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	rule, _, err := client.Users.GetOncallHandoffNotificationRule("PUSER1", "PRULE1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&OncallHandoffNotificationRule{ID: "PRULE1", NotifyAdvanceInMinutes: 60, HandoffType: "both"}); !reflect.DeepEqual(rule, want) {
+		t.Errorf("returned %#v; want %#v", rule, want)
+	}
+
+	rule, _, err = client.Users.UpdateOncallHandoffNotificationRule("PUSER1", "PRULE1", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&OncallHandoffNotificationRule{ID: "PRULE1", NotifyAdvanceInMinutes: 30, HandoffType: "offcall"}); !reflect.DeepEqual(rule, want) {
+		t.Errorf("returned %#v; want %#v", rule, want)
+	}
+
+	if _, err := client.Users.DeleteOncallHandoffNotificationRule("PUSER1", "PRULE1"); err != nil {
+		t.Fatal(err)
+	}
+}
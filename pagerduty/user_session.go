@@ -0,0 +1,85 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// UserSession represents an active session of a user.
+type UserSession struct {
+	ID        string `json:"id,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Summary   string `json:"summary,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// UserSessionPayload represents a user session.
+type UserSessionPayload struct {
+	UserSession *UserSession `json:"user_session,omitempty"`
+}
+
+// ListUserSessionsResponse represents a list response of user sessions.
+type ListUserSessionsResponse struct {
+	UserSessions []*UserSession `json:"user_sessions,omitempty"`
+}
+
+// ListSessions lists the active sessions of a user.
+func (s *UserService) ListSessions(userID string) (*ListUserSessionsResponse, *Response, error) {
+	return s.ListSessionsContext(context.Background(), userID)
+}
+
+// ListSessionsContext lists the active sessions of a user.
+func (s *UserService) ListSessionsContext(ctx context.Context, userID string) (*ListUserSessionsResponse, *Response, error) {
+	u := fmt.Sprintf("/users/%s/sessions", userID)
+	v := new(ListUserSessionsResponse)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, resp, nil
+}
+
+// GetSession retrieves a session of a user. The session type is either
+// "browser" or "mobile".
+func (s *UserService) GetSession(userID, sessionType, sessionID string) (*UserSession, *Response, error) {
+	return s.GetSessionContext(context.Background(), userID, sessionType, sessionID)
+}
+
+// GetSessionContext retrieves a session of a user. The session type is
+// either "browser" or "mobile".
+func (s *UserService) GetSessionContext(ctx context.Context, userID, sessionType, sessionID string) (*UserSession, *Response, error) {
+	u := fmt.Sprintf("/users/%s/sessions/%s/%s", userID, sessionType, sessionID)
+	v := new(UserSessionPayload)
+
+	resp, err := s.client.newRequestDoContext(ctx, "GET", u, nil, nil, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v.UserSession, resp, nil
+}
+
+// DeleteSession revokes a single session of a user.
+func (s *UserService) DeleteSession(userID, sessionType, sessionID string) (*Response, error) {
+	return s.DeleteSessionContext(context.Background(), userID, sessionType, sessionID)
+}
+
+// DeleteSessionContext revokes a single session of a user.
+func (s *UserService) DeleteSessionContext(ctx context.Context, userID, sessionType, sessionID string) (*Response, error) {
+	u := fmt.Sprintf("/users/%s/sessions/%s/%s", userID, sessionType, sessionID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
+
+// DeleteAllSessions revokes every session of a user.
+func (s *UserService) DeleteAllSessions(userID string) (*Response, error) {
+	return s.DeleteAllSessionsContext(context.Background(), userID)
+}
+
+// DeleteAllSessionsContext revokes every session of a user.
+func (s *UserService) DeleteAllSessionsContext(ctx context.Context, userID string) (*Response, error) {
+	u := fmt.Sprintf("/users/%s/sessions", userID)
+	return s.client.newRequestDoContext(ctx, "DELETE", u, nil, nil, nil)
+}
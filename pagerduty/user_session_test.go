@@ -0,0 +1,72 @@
+package pagerduty
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsersListSessions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PUSER1/sessions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"user_sessions": [{"id": "abc", "user_id": "PUSER1", "type": "browser", "summary": "Chrome on Linux", "created_at": "2024-01-01T00:00:00Z"}]}`))
+	})
+
+	resp, _, err := client.Users.ListSessions("PUSER1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ListUserSessionsResponse{
+		UserSessions: []*UserSession{{ID: "abc", UserID: "PUSER1", Type: "browser", Summary: "Chrome on Linux", CreatedAt: "2024-01-01T00:00:00Z"}},
+	}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersGetSession(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PUSER1/sessions/mobile/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"user_session": {"id": "abc", "user_id": "PUSER1", "type": "mobile"}}`))
+	})
+
+	resp, _, err := client.Users.GetSession("PUSER1", "mobile", "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &UserSession{ID: "abc", UserID: "PUSER1", Type: "mobile"}
+
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("returned %#v; want %#v", resp, want)
+	}
+}
+
+func TestUsersDeleteSessions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/PUSER1/sessions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/users/PUSER1/sessions/browser/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Users.DeleteSession("PUSER1", "browser", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Users.DeleteAllSessions("PUSER1"); err != nil {
+		t.Fatal(err)
+	}
+}